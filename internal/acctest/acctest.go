@@ -58,6 +58,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/dns"
+	"github.com/hashicorp/terraform-provider-aws/internal/emulator"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -321,10 +322,20 @@ func PreCheck(ctx context.Context, t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
-		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
+		// When the AWS service emulator is enabled, no AWS credentials are required.
+		var config map[string]any
+		if emulator.IsEnabled() {
+			server, err := emulatorServer()
+			if err != nil {
+				t.Fatalf("starting AWS service emulator: %s", err)
+			}
+			config = emulatorProviderConfig(server)
+		} else {
+			envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
 
-		if os.Getenv(envvar.AccessKeyId) != "" {
-			envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
+			if os.Getenv(envvar.AccessKeyId) != "" {
+				envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
+			}
 		}
 
 		// Setting the AWS_DEFAULT_REGION environment variable here allows all tests to omit
@@ -338,7 +349,7 @@ func PreCheck(ctx context.Context, t *testing.T) {
 		os.Setenv(envvar.DefaultRegion, region)
 
 		Provider.TerraformVersion = "1.0.0"
-		diags := Provider.Configure(ctx, terraformsdk.NewResourceConfigRaw(nil))
+		diags := Provider.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))
 		if err := sdkdiag.DiagnosticsError(diags); err != nil {
 			t.Fatalf("configuring provider: %s", err)
 		}
@@ -1364,7 +1375,7 @@ func PreCheckSSOAdminInstancesWithRegion(ctx context.Context, t *testing.T, regi
 	t.Helper()
 
 	// Push region into Context.
-	ctx = conns.NewResourceContext(ctx, "", "", "", region)
+	ctx = conns.NewResourceContext(ctx, "", "", "", region, "")
 	conn := Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
 	input := ssoadmin.ListInstancesInput{}
 	var instances []ssoadmintypes.InstanceMetadata
@@ -1593,7 +1604,7 @@ func NamedProvider(name string, providers map[string]*schema.Provider) *schema.P
 
 // NewTestResourceContext bootstaps the testing context for a given resource type
 func NewTestResourceContext(ctx context.Context, rType, region string) context.Context {
-	return conns.NewResourceContext(ctx, "", "", rType, region)
+	return conns.NewResourceContext(ctx, "", "", rType, region, "")
 }
 
 func DeleteResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta any) error {
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// This file contains helper functions for running acceptance tests against
+// the in-process AWS service emulator.
+//
+// A single emulator server is started on first use and shared by all tests
+// in the test binary. Provider configurations have the endpoints of emulated
+// services (and STS, used to determine the caller's account ID) pointed at
+// the emulator and use static credentials, so no AWS account is required.
+
+package acctest
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/emulator"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// emulatorServer returns the shared emulator server, starting it on first use.
+// The server runs until the test binary exits.
+var emulatorServer = sync.OnceValues(func() (*emulator.Server, error) {
+	return emulator.New(emulatorServices()...)
+})
+
+// emulatorServices returns the services to emulate.
+func emulatorServices() []string {
+	services := emulator.EnabledServices()
+
+	if !slices.Contains(services, names.STS) {
+		services = append(services, names.STS)
+	}
+
+	return services
+}
+
+// emulatorProviderConfig returns the provider configuration arguments for use with the emulator.
+func emulatorProviderConfig(server *emulator.Server) map[string]any {
+	endpoints := make(map[string]any)
+	for _, service := range emulatorServices() {
+		endpoints[service] = server.URL()
+	}
+
+	return map[string]any{
+		"access_key":              emulator.AccessKeyID,
+		"endpoints":               []any{endpoints},
+		"s3_use_path_style":       true,
+		"secret_key":              emulator.SecretAccessKey,
+		"skip_metadata_api_check": "true",
+	}
+}
+
+// emulatorEnabledProtoV5ProviderFactories returns ProtoV5ProviderFactories ready for use
+// with the emulator
+func emulatorEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input map[string]func() (tfprotov5.ProviderServer, error)) map[string]func() (tfprotov5.ProviderServer, error) {
+	t.Helper()
+
+	output := make(map[string]func() (tfprotov5.ProviderServer, error), len(input))
+
+	for name := range input {
+		output[name] = func() (tfprotov5.ProviderServer, error) {
+			providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			primary.ConfigureContextFunc = emulatorProviderConfigureContextFunc(primary.ConfigureContextFunc)
+
+			return providerServerFactory(), nil
+		}
+	}
+
+	return output
+}
+
+// emulatorProviderConfigureContextFunc returns a provider configuration function that
+// overrides the configured endpoints and credentials with those of the emulator
+func emulatorProviderConfigureContextFunc(configureContextFunc schema.ConfigureContextFunc) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		server, err := emulatorServer()
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "starting AWS service emulator: %s", err)
+		}
+
+		for k, v := range emulatorProviderConfig(server) {
+			if err := d.Set(k, v); err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "setting %s: %s", k, err)
+			}
+		}
+
+		return configureContextFunc(ctx, d)
+	}
+}
+
+// emulatorTestCase wraps the test case's provider factories for use with the emulator.
+// Returns false if the test case does not use ProtoV5ProviderFactories.
+func emulatorTestCase(ctx context.Context, t *testing.T, c *resource.TestCase) bool {
+	t.Helper()
+
+	if vcr.IsEnabled() {
+		t.Fatal("VCR and the AWS service emulator cannot be enabled together")
+	}
+
+	if c.ExternalProviders != nil {
+		return false
+	}
+
+	if c.ProtoV5ProviderFactories != nil {
+		c.ProtoV5ProviderFactories = emulatorEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
+		return true
+	}
+
+	var hasFactories bool
+	for i := range c.Steps {
+		if c.Steps[i].ExternalProviders != nil {
+			return false
+		}
+		if c.Steps[i].ProtoV5ProviderFactories != nil {
+			hasFactories = true
+			c.Steps[i].ProtoV5ProviderFactories = emulatorEnabledProtoV5ProviderFactories(ctx, t, c.Steps[i].ProtoV5ProviderFactories)
+		}
+	}
+	return hasFactories
+}
@@ -6,4 +6,8 @@ package acctest
 // Exports for use in tests only.
 var (
 	CloseVCRRecorder = closeVCRRecorder
+	NewVCRSecrets    = newVCRSecrets
+	VCRHTTPClient    = vcrHTTPClient
+	VCRMatcherFunc   = vcrMatcherFunc
+	VCRRedactionHook = vcrRedactionHook
 )
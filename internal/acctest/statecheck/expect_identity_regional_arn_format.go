@@ -37,10 +37,11 @@ func (e expectIdentityRegionalARNFormatCheck) CheckState(ctx context.Context, re
 		return
 	}
 
-	if len(resource.IdentityValues) > 1 {
-		deltaMsg := createDeltaString(resource.IdentityValues, map[string]bool{"arn": true}, "actual identity has extra attribute(s): ")
+	// Regional resources' identities also include the injected "assume_role" attribute.
+	if expected := map[string]bool{"arn": true, "assume_role": true}; len(resource.IdentityValues) > len(expected) {
+		deltaMsg := createDeltaString(resource.IdentityValues, expected, "actual identity has extra attribute(s): ")
 
-		response.Error = fmt.Errorf("%s - Expected %d attribute(s) in the actual identity object, got %d attribute(s): %s", e.base.ResourceAddress(), 1, len(resource.IdentityValues), deltaMsg)
+		response.Error = fmt.Errorf("%s - Expected %d attribute(s) in the actual identity object, got %d attribute(s): %s", e.base.ResourceAddress(), len(expected), len(resource.IdentityValues), deltaMsg)
 		return
 	}
 
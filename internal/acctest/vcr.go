@@ -26,14 +26,12 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/xml"
 	"fmt"
 	"io"
 	"math/rand" // nosemgrep: go.lang.security.audit.crypto.math_random.math-random-used -- Deterministic PRNG required for VCR test reproducibility
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -46,8 +44,8 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/emulator"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
@@ -223,7 +221,8 @@ func vcrSensitiveHeaderHook(i *cassette.Interaction) error {
 }
 
 // vcrMatcherFunc defines how VCR will match requests to stored interactions.
-func vcrMatcherFunc(ctx context.Context) recorder.MatcherFunc {
+// Secret values redacted from outgoing requests are recorded in secrets.
+func vcrMatcherFunc(ctx context.Context, secrets *vcrSecrets) recorder.MatcherFunc {
 	return func(r *http.Request, i cassette.Request) bool {
 		if r.Method != i.Method {
 			return false
@@ -246,40 +245,8 @@ func vcrMatcherFunc(ctx context.Context) recorder.MatcherFunc {
 		}
 
 		r.Body = io.NopCloser(&b)
-		body := b.String()
-		// If body matches identically, we are done.
-		if body == i.Body {
-			return true
-		}
 
-		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-		switch contentType := r.Header.Get("Content-Type"); contentType {
-		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-			// JSON might be the same, but reordered. Try parsing and comparing.
-			return tfjson.EqualStrings(body, i.Body)
-
-		case "application/xml":
-			// XML might be the same, but reordered. Try parsing and comparing.
-			var requestXML, cassetteXML any
-
-			if err := xml.Unmarshal([]byte(body), &requestXML); err != nil {
-				tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
-					"error": err,
-				})
-				return false
-			}
-
-			if err := xml.Unmarshal([]byte(i.Body), &cassetteXML); err != nil {
-				tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
-					"error": err,
-				})
-				return false
-			}
-
-			return reflect.DeepEqual(requestXML, cassetteXML)
-		}
-
-		return false
+		return vcrRequestBodiesMatch(ctx, r, b.String(), i.Body, secrets)
 	}
 }
 
@@ -300,11 +267,15 @@ func vcrHTTPClient(ctx context.Context, testName string) (*http.Client, error) {
 	}
 
 	cassetteName := filepath.Join(vcr.Path(), vcrFileName(testName))
+	secrets := newVCRSecrets()
 
 	// Create a VCR recorder around a default HTTP client.
 	r, err := recorder.New(cassetteName,
+		recorder.WithHook(vcrRedactionHook(secrets), recorder.AfterCaptureHook),
 		recorder.WithHook(vcrSensitiveHeaderHook, recorder.AfterCaptureHook),
-		recorder.WithMatcher(vcrMatcherFunc(ctx)),
+		recorder.WithHook(vcrSecretRestorationHook(secrets), recorder.BeforeResponseReplayHook),
+		recorder.WithHook(vcrSecretRedactionHook(secrets), recorder.BeforeSaveHook),
+		recorder.WithMatcher(vcrMatcherFunc(ctx, secrets)),
 		recorder.WithMode(vcrMode),
 		recorder.WithRealTransport(httpClient.Transport),
 		recorder.WithSkipRequestLatency(true),
@@ -449,10 +420,16 @@ func vcrTestCase(ctx context.Context, t *testing.T, c *resource.TestCase) bool {
 	return hasFactories
 }
 
-// ParallelTest wraps resource.ParallelTest, initializing VCR if enabled
+// ParallelTest wraps resource.ParallelTest, initializing VCR or the AWS service emulator if enabled
 func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
+	if emulator.IsEnabled() {
+		if !emulatorTestCase(ctx, t, &c) {
+			t.Skip("ProtoV5ProviderFactories not set at TestCase or TestStep level")
+		}
+	}
+
 	if vcr.IsEnabled() {
 		if !vcrTestCase(ctx, t, &c) {
 			t.Skip("ProtoV5ProviderFactories not set at TestCase or TestStep level")
@@ -463,10 +440,16 @@ func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	resource.ParallelTest(t, c)
 }
 
-// Test wraps resource.Test, initializing VCR if enabled
+// Test wraps resource.Test, initializing VCR or the AWS service emulator if enabled
 func Test(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
+	if emulator.IsEnabled() {
+		if !emulatorTestCase(ctx, t, &c) {
+			t.Skip("ProtoV5ProviderFactories not set at TestCase or TestStep level")
+		}
+	}
+
 	if vcr.IsEnabled() {
 		if !vcrTestCase(ctx, t, &c) {
 			t.Skip("ProtoV5ProviderFactories not set at TestCase or TestStep level")
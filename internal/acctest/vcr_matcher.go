@@ -0,0 +1,298 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream"
+	"github.com/aws/smithy-go/encoding/cbor"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+// Request body media types for the AWS protocols.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+const (
+	vcrMediaTypeAWSJSON10   = "application/x-amz-json-1.0"
+	vcrMediaTypeAWSJSON11   = "application/x-amz-json-1.1"
+	vcrMediaTypeCBOR        = "application/cbor"
+	vcrMediaTypeEventStream = "application/vnd.amazon.eventstream"
+	vcrMediaTypeForm        = "application/x-www-form-urlencoded"
+	vcrMediaTypeJSON        = "application/json"
+	vcrMediaTypeXML         = "application/xml"
+	vcrMediaTypeTextXML     = "text/xml"
+)
+
+// VCRBodyNormalizer rewrites a request body before it is matched against
+// a recorded interaction. The same normalization is applied to both the
+// outgoing request body and the recorded request body.
+//
+// mediaType is the request's Content-Type without parameters.
+// Normalizers must return the body unchanged if it cannot be handled.
+type VCRBodyNormalizer func(mediaType string, body []byte) []byte
+
+var (
+	// vcrBodyNormalizers holds request body normalizers keyed by AWS
+	// Signature Version 4 signing name (e.g. "ec2").
+	vcrBodyNormalizers = map[string][]VCRBodyNormalizer{
+		// The AWS SDK for Go v2 populates idempotency tokens with random values.
+		"ec2": {VCRIgnoreBodyFields("ClientToken")},
+	}
+	vcrBodyNormalizersMu sync.RWMutex
+)
+
+// RegisterVCRBodyNormalizer registers request body normalizers for the AWS
+// service with the specified Signature Version 4 signing name.
+func RegisterVCRBodyNormalizer(signingName string, normalizers ...VCRBodyNormalizer) {
+	vcrBodyNormalizersMu.Lock()
+	defer vcrBodyNormalizersMu.Unlock()
+
+	vcrBodyNormalizers[signingName] = append(vcrBodyNormalizers[signingName], normalizers...)
+}
+
+// VCRIgnoreBodyFields returns a VCRBodyNormalizer that removes the specified
+// top-level fields from JSON, CBOR and form-urlencoded request bodies.
+func VCRIgnoreBodyFields(fields ...string) VCRBodyNormalizer {
+	return func(mediaType string, body []byte) []byte {
+		switch mediaType {
+		case vcrMediaTypeJSON, vcrMediaTypeAWSJSON10, vcrMediaTypeAWSJSON11:
+			var m map[string]any
+			if err := json.Unmarshal(body, &m); err != nil {
+				return body
+			}
+			for _, field := range fields {
+				delete(m, field)
+			}
+			if b, err := json.Marshal(m); err == nil {
+				return b
+			}
+
+		case vcrMediaTypeCBOR:
+			v, err := cbor.Decode(body)
+			if err != nil {
+				return body
+			}
+			m, ok := v.(cbor.Map)
+			if !ok {
+				return body
+			}
+			for _, field := range fields {
+				delete(m, field)
+			}
+			return cbor.Encode(m)
+
+		case vcrMediaTypeForm:
+			values, err := url.ParseQuery(string(body))
+			if err != nil {
+				return body
+			}
+			for _, field := range fields {
+				values.Del(field)
+			}
+			return []byte(values.Encode())
+		}
+
+		return body
+	}
+}
+
+// vcrRequestBodiesMatch returns whether or not the body of an outgoing request
+// matches the body of a recorded request. Secret values redacted from the
+// outgoing request body are recorded in secrets.
+func vcrRequestBodiesMatch(ctx context.Context, r *http.Request, body, cassetteBody string, secrets *vcrSecrets) bool {
+	// If body matches identically, we are done.
+	if body == cassetteBody {
+		return true
+	}
+
+	mediaType := vcrMediaType(r.Header)
+	signingName := vcrSigningNameFromAuthorization(r.Header.Get("Authorization"))
+
+	vcrBodyNormalizersMu.RLock()
+	normalizers := vcrBodyNormalizers[signingName]
+	vcrBodyNormalizersMu.RUnlock()
+
+	// Recorded request bodies have sensitive values redacted.
+	if normalize := vcrRedactionNormalizer(signingName, secrets); normalize != nil {
+		normalizers = append([]VCRBodyNormalizer{normalize}, normalizers...)
+	}
+
+	if len(normalizers) > 0 {
+		b, cb := []byte(body), []byte(cassetteBody)
+		for _, normalize := range normalizers {
+			b, cb = normalize(mediaType, b), normalize(mediaType, cb)
+		}
+
+		if bytes.Equal(b, cb) {
+			return true
+		}
+
+		body, cassetteBody = string(b), string(cb)
+	}
+
+	// https://smithy.io/2.0/aws/protocols/index.html.
+	switch mediaType {
+	case vcrMediaTypeJSON, vcrMediaTypeAWSJSON10, vcrMediaTypeAWSJSON11:
+		// JSON might be the same, but reordered. Try parsing and comparing.
+		return tfjson.EqualStrings(body, cassetteBody)
+
+	case vcrMediaTypeXML, vcrMediaTypeTextXML:
+		// XML might be the same, but reordered. Try parsing and comparing.
+		var requestXML, cassetteXML any
+
+		if err := xml.Unmarshal([]byte(body), &requestXML); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		if err := xml.Unmarshal([]byte(cassetteBody), &cassetteXML); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		return reflect.DeepEqual(requestXML, cassetteXML)
+
+	case vcrMediaTypeForm:
+		// AWS Query and EC2 Query protocol parameters might be the same, but reordered.
+		requestValues, err := url.ParseQuery(body)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to parse request form", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		cassetteValues, err := url.ParseQuery(cassetteBody)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to parse cassette form", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		return reflect.DeepEqual(requestValues, cassetteValues)
+
+	case vcrMediaTypeCBOR:
+		// Smithy RPC v2 CBOR maps might be the same, but encoded in a different order.
+		requestCBOR, err := cbor.Decode([]byte(body))
+		if err != nil {
+			tflog.Debug(ctx, "Failed to decode request CBOR", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		cassetteCBOR, err := cbor.Decode([]byte(cassetteBody))
+		if err != nil {
+			tflog.Debug(ctx, "Failed to decode cassette CBOR", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		return reflect.DeepEqual(requestCBOR, cassetteCBOR)
+
+	case vcrMediaTypeEventStream:
+		// Event stream messages are signed and timestamped on every request.
+		requestMessages, err := vcrDecodeEventStream([]byte(body))
+		if err != nil {
+			tflog.Debug(ctx, "Failed to decode request event stream", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		cassetteMessages, err := vcrDecodeEventStream([]byte(cassetteBody))
+		if err != nil {
+			tflog.Debug(ctx, "Failed to decode cassette event stream", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		return reflect.DeepEqual(requestMessages, cassetteMessages)
+	}
+
+	return false
+}
+
+// vcrSigningNameFromAuthorization returns the AWS Signature Version 4 signing name
+// from an Authorization header value, or an empty string if the request is unsigned.
+func vcrSigningNameFromAuthorization(authorization string) string {
+	// AWS4-HMAC-SHA256 Credential=AKID/20260101/us-west-2/ec2/aws4_request, SignedHeaders=..., Signature=...
+	_, credential, ok := strings.Cut(authorization, "Credential=")
+	if !ok {
+		return ""
+	}
+
+	credential, _, _ = strings.Cut(credential, ",")
+	if parts := strings.Split(credential, "/"); len(parts) == 5 { //nolint:mnd // AKID/date/region/service/aws4_request
+		return parts[3]
+	}
+
+	return ""
+}
+
+// vcrEventStreamMessage is the comparable form of an event stream message.
+type vcrEventStreamMessage struct {
+	Headers map[string]string
+	Payload string
+}
+
+// vcrDecodeEventStream decodes an event stream request body, unwrapping
+// signed messages and discarding per-message signatures and dates.
+func vcrDecodeEventStream(body []byte) ([]vcrEventStreamMessage, error) {
+	var messages []vcrEventStreamMessage
+
+	decoder := eventstream.NewDecoder()
+	reader := bytes.NewReader(body)
+	for reader.Len() > 0 {
+		message, err := decoder.Decode(reader, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		// Signed messages wrap the event message in their payload.
+		if message.Headers.Get(":chunk-signature") != nil {
+			// The final signed message has an empty payload.
+			if len(message.Payload) == 0 {
+				continue
+			}
+
+			message, err = decoder.Decode(bytes.NewReader(message.Payload), nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		headers := make(map[string]string, len(message.Headers))
+		for _, header := range message.Headers {
+			switch header.Name {
+			case ":chunk-signature", ":date":
+				continue
+			}
+			headers[header.Name] = header.Value.String()
+		}
+
+		messages = append(messages, vcrEventStreamMessage{
+			Headers: headers,
+			Payload: string(message.Payload),
+		})
+	}
+
+	return messages, nil
+}
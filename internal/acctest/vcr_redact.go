@@ -0,0 +1,507 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/smithy-go/encoding/cbor"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+// VCRRedactedValue replaces sensitive values in recorded interactions.
+const VCRRedactedValue = "REDACTED"
+
+// VCRRedactionRule identifies sensitive values in request and response bodies.
+//
+// Values are redacted from request bodies unless otherwise noted. Recorded
+// responses are replayed as-is, so a value may only be redacted from response
+// bodies if it is never read into Terraform state or is a secret value.
+type VCRRedactionRule struct {
+	// JSONPaths are dot-separated paths to values in JSON and CBOR documents,
+	// e.g. "SecretString". "*" matches any object key or array element.
+	JSONPaths []string
+
+	// QueryParameters are AWS Query and EC2 Query protocol parameter names,
+	// e.g. "MasterUserPassword". "*" matches any single dot-separated name
+	// segment, e.g. "Passwords.member.*".
+	QueryParameters []string
+
+	// XMLElements are the local names of XML elements whose character data is redacted,
+	// e.g. "Password".
+	XMLElements []string
+
+	// ResponseJSONPaths are JSONPaths redacted from response bodies.
+	ResponseJSONPaths []string
+
+	// ResponseXMLElements are XMLElements redacted from response bodies,
+	// e.g. "SessionToken".
+	ResponseXMLElements []string
+
+	// SecretJSONPaths are JSONPaths to secret values that may be read into
+	// Terraform state, e.g. "SecretString". They are redacted from request and
+	// response bodies with a placeholder derived from the value. When a response
+	// is replayed, placeholders are replaced with the values sent in earlier requests.
+	SecretJSONPaths []string
+}
+
+// vcrBodyRedactor redacts sensitive values from request or response bodies.
+type vcrBodyRedactor struct {
+	jsonPaths       [][]string
+	queryParameters []string
+	secretJSONPaths [][]string
+	xmlElements     []*regexp.Regexp
+}
+
+// vcrRedactor is a VCRRedactionRule compiled at registration.
+type vcrRedactor struct {
+	request  vcrBodyRedactor
+	response vcrBodyRedactor
+}
+
+func newVCRRedactor(rule VCRRedactionRule) vcrRedactor {
+	secretJSONPaths := splitJSONPaths(rule.SecretJSONPaths)
+
+	return vcrRedactor{
+		request: vcrBodyRedactor{
+			jsonPaths:       splitJSONPaths(rule.JSONPaths),
+			queryParameters: rule.QueryParameters,
+			secretJSONPaths: secretJSONPaths,
+			xmlElements:     xmlElementRegexps(rule.XMLElements),
+		},
+		response: vcrBodyRedactor{
+			jsonPaths:       splitJSONPaths(rule.ResponseJSONPaths),
+			secretJSONPaths: secretJSONPaths,
+			xmlElements:     xmlElementRegexps(rule.ResponseXMLElements),
+		},
+	}
+}
+
+func newVCRRedactors(rules map[string][]VCRRedactionRule) map[string][]vcrRedactor {
+	m := make(map[string][]vcrRedactor, len(rules))
+	for signingName, rules := range rules {
+		for _, rule := range rules {
+			m[signingName] = append(m[signingName], newVCRRedactor(rule))
+		}
+	}
+	return m
+}
+
+var (
+	// vcrRedactionRules holds redaction rules keyed by AWS Signature Version 4
+	// signing name (e.g. "secretsmanager").
+	vcrRedactionRules = newVCRRedactors(map[string][]VCRRedactionRule{
+		"acm": {{
+			JSONPaths: []string{"Passphrase", "PrivateKey"},
+		}},
+		"elasticache": {{
+			QueryParameters: []string{"AuthToken", "Passwords.member.*"},
+		}},
+		"iam": {{
+			QueryParameters: []string{"NewPassword", "OldPassword", "Password", "PrivateKey"},
+		}},
+		"kms": {{
+			SecretJSONPaths: []string{"Plaintext"},
+		}},
+		"rds": {{
+			QueryParameters: []string{"MasterUserPassword"},
+		}},
+		"redshift": {{
+			QueryParameters: []string{"MasterUserPassword"},
+		}},
+		"secretsmanager": {{
+			SecretJSONPaths: []string{"SecretBinary", "SecretString"},
+		}},
+		"sts": {{
+			// Temporary credentials are only used to sign requests.
+			ResponseXMLElements: []string{"SecretAccessKey", "SessionToken"},
+		}},
+	})
+	vcrRedactionRulesMu sync.RWMutex
+)
+
+// RegisterVCRRedactionRule registers redaction rules for the AWS service with
+// the specified Signature Version 4 signing name.
+func RegisterVCRRedactionRule(signingName string, rules ...VCRRedactionRule) {
+	vcrRedactionRulesMu.Lock()
+	defer vcrRedactionRulesMu.Unlock()
+
+	for _, rule := range rules {
+		vcrRedactionRules[signingName] = append(vcrRedactionRules[signingName], newVCRRedactor(rule))
+	}
+}
+
+func vcrRedactors(signingName string) []vcrRedactor {
+	vcrRedactionRulesMu.RLock()
+	defer vcrRedactionRulesMu.RUnlock()
+
+	return vcrRedactionRules[signingName]
+}
+
+// vcrRedactionHook returns an after capture hook to redact sensitive values
+// from request and response bodies. Redacted secret values are recorded in secrets.
+//
+// It must run before vcrSensitiveHeaderHook, which removes the Authorization
+// header used to determine the AWS service.
+func vcrRedactionHook(secrets *vcrSecrets) recorder.HookFunc {
+	return func(i *cassette.Interaction) error {
+		redactors := vcrRedactors(vcrSigningNameFromAuthorization(i.Request.Headers.Get("Authorization")))
+		if len(redactors) == 0 {
+			return nil
+		}
+
+		mediaType, body := vcrMediaType(i.Request.Headers), []byte(i.Request.Body)
+		for _, redactor := range redactors {
+			body = redactor.request.redact(mediaType, body, secrets)
+		}
+		if body := string(body); body != i.Request.Body {
+			i.Request.Body = body
+			i.Request.ContentLength = int64(len(body))
+			if i.Request.Form != nil && mediaType == vcrMediaTypeForm {
+				if form, err := url.ParseQuery(body); err == nil {
+					i.Request.Form = form
+				}
+			}
+		}
+
+		mediaType, body = vcrMediaType(i.Response.Headers), []byte(i.Response.Body)
+		for _, redactor := range redactors {
+			body = redactor.response.redact(mediaType, body, secrets)
+		}
+		setVCRResponseBody(i, string(body))
+
+		return nil
+	}
+}
+
+// vcrSecretRestorationHook returns a before response replay hook to replace
+// placeholders in response bodies with the secret values recorded in secrets.
+//
+// Secret values are recorded when requests are redacted or matched, so a
+// placeholder is only restored if its value has been sent in an earlier request
+// or, when recording, was returned in the response.
+func vcrSecretRestorationHook(secrets *vcrSecrets) recorder.HookFunc {
+	return func(i *cassette.Interaction) error {
+		setVCRResponseBody(i, secrets.restore(i.Response.Body))
+
+		return nil
+	}
+}
+
+// vcrSecretRedactionHook returns a before save hook to redact the secret values
+// restored by vcrSecretRestorationHook from response bodies.
+func vcrSecretRedactionHook(secrets *vcrSecrets) recorder.HookFunc {
+	return func(i *cassette.Interaction) error {
+		setVCRResponseBody(i, secrets.redact(i.Response.Body))
+
+		return nil
+	}
+}
+
+func setVCRResponseBody(i *cassette.Interaction, body string) {
+	if body == i.Response.Body {
+		return
+	}
+
+	i.Response.Body = body
+	i.Response.ContentLength = int64(len(body))
+	if i.Response.Headers.Get("Content-Length") != "" {
+		i.Response.Headers.Set("Content-Length", strconv.Itoa(len(body)))
+	}
+}
+
+// vcrRedactionNormalizer returns a VCRBodyNormalizer applying the request
+// redaction rules registered for the specified signing name, or nil if there are none.
+//
+// The normalizer is applied to outgoing requests when matching so that
+// redacted recordings can be replayed. Redacted secret values are recorded in secrets.
+func vcrRedactionNormalizer(signingName string, secrets *vcrSecrets) VCRBodyNormalizer {
+	redactors := vcrRedactors(signingName)
+	if len(redactors) == 0 {
+		return nil
+	}
+
+	return func(mediaType string, body []byte) []byte {
+		for _, redactor := range redactors {
+			body = redactor.request.redact(mediaType, body, secrets)
+		}
+		return body
+	}
+}
+
+func (r vcrBodyRedactor) redact(mediaType string, body []byte, secrets *vcrSecrets) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	switch mediaType {
+	case vcrMediaTypeJSON, vcrMediaTypeAWSJSON10, vcrMediaTypeAWSJSON11:
+		if len(r.jsonPaths) == 0 && len(r.secretJSONPaths) == 0 {
+			return body
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+		var v any
+		if err := decoder.Decode(&v); err != nil {
+			return body
+		}
+
+		var redacted bool
+		for _, path := range r.jsonPaths {
+			v = redactJSONPath(v, path, func(any) any {
+				return VCRRedactedValue
+			}, &redacted)
+		}
+		for _, path := range r.secretJSONPaths {
+			v = redactJSONPath(v, path, func(v any) any {
+				if v, ok := v.(string); ok {
+					return secrets.placeholder(v)
+				}
+				return VCRRedactedValue
+			}, &redacted)
+		}
+		if !redacted {
+			return body
+		}
+
+		if b, err := json.Marshal(v); err == nil {
+			return b
+		}
+
+	case vcrMediaTypeCBOR:
+		if len(r.jsonPaths) == 0 && len(r.secretJSONPaths) == 0 {
+			return body
+		}
+
+		v, err := cbor.Decode(body)
+		if err != nil {
+			return body
+		}
+
+		var redacted bool
+		for _, path := range r.jsonPaths {
+			v = redactCBORPath(v, path, func(cbor.Value) cbor.Value {
+				return cbor.String(VCRRedactedValue)
+			}, &redacted)
+		}
+		for _, path := range r.secretJSONPaths {
+			v = redactCBORPath(v, path, func(v cbor.Value) cbor.Value {
+				if v, ok := v.(cbor.String); ok {
+					return cbor.String(secrets.placeholder(string(v)))
+				}
+				return cbor.String(VCRRedactedValue)
+			}, &redacted)
+		}
+		if !redacted {
+			return body
+		}
+
+		return cbor.Encode(v)
+
+	case vcrMediaTypeForm:
+		if len(r.queryParameters) == 0 {
+			return body
+		}
+
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return body
+		}
+
+		var redacted bool
+		for name := range values {
+			for _, pattern := range r.queryParameters {
+				if matchQueryParameterName(pattern, name) {
+					values.Set(name, VCRRedactedValue)
+					redacted = true
+				}
+			}
+		}
+		if !redacted {
+			return body
+		}
+
+		return []byte(values.Encode())
+
+	case vcrMediaTypeXML, vcrMediaTypeTextXML:
+		for _, re := range r.xmlElements {
+			body = re.ReplaceAll(body, []byte("${1}"+VCRRedactedValue+"${2}"))
+		}
+	}
+
+	return body
+}
+
+// vcrSecretPlaceholderRegexp matches the placeholders that replace secret values.
+// Placeholders are valid base64 so that they can replace blob values.
+var vcrSecretPlaceholderRegexp = regexache.MustCompile(`^` + VCRRedactedValue + `[0-9a-f]{16}$`)
+
+// vcrSecrets records the secret values replaced by placeholders in an
+// interaction so that they can be restored when its response is replayed.
+type vcrSecrets struct {
+	mu     sync.Mutex
+	values map[string]string // Keyed by placeholder.
+}
+
+func newVCRSecrets() *vcrSecrets {
+	return &vcrSecrets{
+		values: make(map[string]string),
+	}
+}
+
+// placeholder returns the placeholder for the specified secret value,
+// recording the value if s is not nil.
+func (s *vcrSecrets) placeholder(value string) string {
+	if vcrSecretPlaceholderRegexp.MatchString(value) {
+		return value
+	}
+
+	sum := sha256.Sum256([]byte(value))
+	placeholder := fmt.Sprintf("%s%x", VCRRedactedValue, sum[:8])
+
+	if s != nil {
+		s.mu.Lock()
+		s.values[placeholder] = value
+		s.mu.Unlock()
+	}
+
+	return placeholder
+}
+
+// restore replaces placeholder JSON strings in body with the secret values they replace.
+func (s *vcrSecrets) restore(body string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for placeholder, value := range s.values {
+		body = strings.ReplaceAll(body, vcrJSONString(placeholder), vcrJSONString(value))
+	}
+
+	return body
+}
+
+// redact replaces secret value JSON strings in body with their placeholders.
+func (s *vcrSecrets) redact(body string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for placeholder, value := range s.values {
+		body = strings.ReplaceAll(body, vcrJSONString(value), vcrJSONString(placeholder))
+	}
+
+	return body
+}
+
+func vcrJSONString(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+func splitJSONPaths(paths []string) [][]string {
+	return tfslices.ApplyToAll(paths, func(path string) []string {
+		return strings.Split(path, ".")
+	})
+}
+
+func redactJSONPath(v any, path []string, redact func(any) any, redacted *bool) any {
+	if len(path) == 0 {
+		if v == nil {
+			return v
+		}
+		*redacted = true
+		return redact(v)
+	}
+
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if path[0] == "*" || path[0] == key {
+				v[key] = redactJSONPath(value, path[1:], redact, redacted)
+			}
+		}
+	case []any:
+		if path[0] == "*" {
+			for i, value := range v {
+				v[i] = redactJSONPath(value, path[1:], redact, redacted)
+			}
+		}
+	}
+
+	return v
+}
+
+func redactCBORPath(v cbor.Value, path []string, redact func(cbor.Value) cbor.Value, redacted *bool) cbor.Value {
+	if len(path) == 0 {
+		if _, ok := v.(*cbor.Nil); ok || v == nil {
+			return v
+		}
+		*redacted = true
+		return redact(v)
+	}
+
+	switch v := v.(type) {
+	case cbor.Map:
+		for key, value := range v {
+			if path[0] == "*" || path[0] == key {
+				v[key] = redactCBORPath(value, path[1:], redact, redacted)
+			}
+		}
+	case cbor.List:
+		if path[0] == "*" {
+			for i, value := range v {
+				v[i] = redactCBORPath(value, path[1:], redact, redacted)
+			}
+		}
+	}
+
+	return v
+}
+
+func matchQueryParameterName(pattern, name string) bool {
+	patternSegments, nameSegments := strings.Split(pattern, "."), strings.Split(name, ".")
+	if len(patternSegments) != len(nameSegments) {
+		return false
+	}
+
+	for i, segment := range patternSegments {
+		if segment != "*" && segment != nameSegments[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// xmlElementRegexps returns regular expressions matching the character data of
+// XML elements with the specified local names, capturing the start and end tags.
+func xmlElementRegexps(names []string) []*regexp.Regexp {
+	return tfslices.ApplyToAll(names, func(name string) *regexp.Regexp {
+		name = regexp.QuoteMeta(name)
+		return regexache.MustCompile(fmt.Sprintf(`(<(?:[\w.-]+:)?%[1]s(?:\s[^>]*)?>)[^<]*(</(?:[\w.-]+:)?%[1]s>)`, name))
+	})
+}
+
+// vcrMediaType returns the media type of the specified headers' Content-Type.
+func vcrMediaType(headers http.Header) string {
+	mediaType, _, err := mime.ParseMediaType(headers.Get("Content-Type"))
+	if err != nil {
+		return ""
+	}
+
+	return mediaType
+}
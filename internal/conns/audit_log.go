@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// auditLogRecord is a single line of the AWS API call audit log.
+type auditLogRecord struct {
+	Time           string `json:"time"`
+	Service        string `json:"service"`
+	ServicePackage string `json:"service_package,omitempty"`
+	Operation      string `json:"operation"`
+	Region         string `json:"region,omitempty"`
+	AccountID      string `json:"account_id,omitempty"`
+	ResourceType   string `json:"resource_type,omitempty"`
+	RequestID      string `json:"request_id,omitempty"`
+	LatencyMS      int64  `json:"latency_ms"`
+	RetryCount     int    `json:"retry_count"`
+	ErrorCode      string `json:"error_code,omitempty"`
+}
+
+// auditLog writes JSON lines describing AWS API calls.
+type auditLog struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+// openAuditLog opens the specified file for appending audit log records.
+func openAuditLog(path string) (*auditLog, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auditLog{w: f}, nil
+}
+
+func (l *auditLog) write(record auditLogRecord) {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return
+	}
+	b = append(b, '\n')
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	// Auditing is best-effort and never fails the API call.
+	_, _ = l.w.Write(b)
+}
+
+// apiOption returns an AWS SDK for Go v2 API option that records every API call made by a client.
+// servicePackageName and accountID are those of the AWS API client being built.
+func (l *auditLog) apiOption(servicePackageName, accountID string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(
+			middleware.InitializeMiddlewareFunc(
+				"AuditLog",
+				func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+					start := time.Now()
+					out, metadata, err := next.HandleInitialize(ctx, in)
+
+					record := auditLogRecord{
+						Time:           start.UTC().Format(time.RFC3339Nano),
+						Service:        awsmiddleware.GetServiceID(ctx),
+						ServicePackage: servicePackageName,
+						Operation:      awsmiddleware.GetOperationName(ctx),
+						Region:         awsmiddleware.GetRegion(ctx),
+						AccountID:      accountID,
+						LatencyMS:      time.Since(start).Milliseconds(),
+					}
+					if inContext, ok := FromContext(ctx); ok {
+						record.ResourceType = inContext.TypeName()
+					}
+					if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+						record.RequestID = v
+					}
+					if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+						record.RetryCount = len(v.Results) - 1
+					}
+					if err != nil {
+						if apiErr, ok := errors.AsType[smithy.APIError](err); ok {
+							record.ErrorCode = apiErr.ErrorCode()
+						} else {
+							record.ErrorCode = "ClientError"
+						}
+					}
+
+					l.write(record)
+
+					return out, metadata, err
+				},
+			),
+			middleware.After, // Operation metadata is registered by the client's own Initialize middleware.
+		)
+	}
+}
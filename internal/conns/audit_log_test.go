@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestAuditLogAPIOption(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err  error
+		want auditLogRecord
+	}{
+		"success": {
+			want: auditLogRecord{
+				Service:        "EC2",
+				ServicePackage: "ec2",
+				Operation:      "DescribeVpcs",
+				Region:         "us-west-2", //lintignore:AWSAT003
+				AccountID:      "123456789012",
+				ResourceType:   "aws_vpc",
+				RequestID:      "req-1",
+			},
+		},
+		"API error": {
+			err: &smithy.GenericAPIError{Code: "UnauthorizedOperation"},
+			want: auditLogRecord{
+				Service:        "EC2",
+				ServicePackage: "ec2",
+				Operation:      "DescribeVpcs",
+				Region:         "us-west-2", //lintignore:AWSAT003
+				AccountID:      "123456789012",
+				ResourceType:   "aws_vpc",
+				RequestID:      "req-1",
+				ErrorCode:      "UnauthorizedOperation",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			l := &auditLog{w: &buf}
+
+			stack := middleware.NewStack("DescribeVpcs", func() any { return nil })
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+				ServiceID:     "EC2",
+				OperationName: "DescribeVpcs",
+				Region:        "us-west-2", //lintignore:AWSAT003
+			}, middleware.Before); err != nil {
+				t.Fatal(err)
+			}
+			if err := l.apiOption("ec2", "123456789012")(stack); err != nil {
+				t.Fatal(err)
+			}
+
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+				var metadata middleware.Metadata
+				awsmiddleware.SetRequestIDMetadata(&metadata, "req-1")
+				return nil, metadata, testCase.err
+			}), stack)
+
+			ctx := NewResourceContext(context.Background(), "ec2", "VPC", "aws_vpc", "", "")
+			if _, _, err := handler.Handle(ctx, nil); !errors.Is(err, testCase.err) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got auditLogRecord
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("unmarshaling audit log record %q: %s", buf.String(), err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want, cmpopts.IgnoreFields(auditLogRecord{}, "Time", "LatencyMS")); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
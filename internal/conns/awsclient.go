@@ -11,13 +11,16 @@ import (
 	"math/rand" // nosemgrep: go.lang.security.audit.crypto.math_random.math-random-used -- Deterministic PRNG required for VCR test reproducibility
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -26,20 +29,29 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 type AWSClient struct {
 	accountID                 string
+	assumeRoleCredentials     sync.Map  // IAM role ARN -> aws.CredentialsProvider. For per-resource IAM role overrides.
+	auditLog                  *auditLog // From provider configuration.
 	awsConfig                 *aws.Config
-	clients                   map[string]map[string]any // Region -> service package name -> API client.
+	clients                   map[string]map[string]any // Region (and any per-resource IAM role) -> service package name -> API client.
 	defaultTagsConfig         *tftags.DefaultConfig
+	defaultTimeoutsConfig     *DefaultTimeoutsConfig
+	deletionGuardConfig       *DeletionGuardConfig
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
 	ignoreTagsConfig          *tftags.IgnoreConfig
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	quotaChecksConfig         *QuotaChecksConfig
+	quotaUsage                map[string]*quotaUsage // Region/account/resource type/scope -> planned usage. For plan-time service quota checks.
+	quotaUsageLock            sync.Mutex
 	randomnessSource          rand.Source // For VCR deterministic randomness.
+	readOnly                  bool        // From provider configuration.
 	servicePackages           map[string]ServicePackage
 	s3ExpressClient           *s3.Client
 	s3OriginalRegion          string // Original region for S3-compatible storage
@@ -47,7 +59,8 @@ type AWSClient struct {
 	s3USEast1RegionalEndpoint string // From provider configuration.
 	stsRegion                 string // From provider configuration.
 	tagPolicyConfig           *tftags.TagPolicyConfig
-	terraformVersion          string // From provider configuration.
+	terraformVersion          string                   // From provider configuration.
+	tracerProvider            *sdktrace.TracerProvider // From provider configuration.
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -71,31 +84,72 @@ func (c *AWSClient) TerraformVersion(_ context.Context) string {
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
-func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider {
+// If the currently in-process operation has defined a per-resource IAM role override,
+// the returned provider assumes that role.
+func (c *AWSClient) CredentialsProvider(ctx context.Context) aws.CredentialsProvider {
 	if c.awsConfig == nil {
 		return nil
 	}
+	if roleARN := overrideAssumeRole(ctx); roleARN != "" {
+		return c.assumeRoleCredentialsProvider(roleARN)
+	}
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
-	return c.defaultTagsConfig
+// DefaultTagsConfig returns the default tags configuration in effect for the resource type and Region in context.
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	var typeName string
+	if inContext, ok := FromContext(ctx); ok {
+		typeName = inContext.TypeName()
+	}
+
+	return c.defaultTagsConfig.Resolve(typeName, c.Region(ctx))
+}
+
+func (c *AWSClient) DefaultTimeoutsConfig(context.Context) *DefaultTimeoutsConfig {
+	return c.defaultTimeoutsConfig
+}
+
+func (c *AWSClient) DeletionGuardConfig(context.Context) *DeletionGuardConfig {
+	return c.deletionGuardConfig
 }
 
 func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
+func (c *AWSClient) QuotaChecksConfig(context.Context) *QuotaChecksConfig {
+	return c.quotaChecksConfig
+}
+
 func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.TagPolicyConfig {
 	return c.tagPolicyConfig
 }
 
-func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
-	return c.awsConfig.Copy()
+func (c *AWSClient) AwsConfig(ctx context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
+	cfg := c.awsConfig.Copy()
+	if roleARN := overrideAssumeRole(ctx); roleARN != "" {
+		cfg.Credentials = c.assumeRoleCredentialsProvider(roleARN)
+	}
+	return cfg
+}
+
+// AccountID returns the ID of the effective AWS account.
+// If the currently in-process operation has defined a per-resource IAM role override,
+// that role's account ID is returned, otherwise the configured account ID is returned.
+func (c *AWSClient) AccountID(ctx context.Context) string {
+	return c.AccountIDForAssumeRole(overrideAssumeRole(ctx))
 }
 
-// AccountID returns the configured AWS account ID.
-func (c *AWSClient) AccountID(context.Context) string {
+// AccountIDForAssumeRole returns the ID of the AWS account used when the specified per-resource IAM role is assumed.
+// If no role is specified, the configured account ID is returned.
+func (c *AWSClient) AccountIDForAssumeRole(roleARN string) string {
+	if roleARN != "" {
+		if v, err := arn.Parse(roleARN); err == nil {
+			return v.AccountID
+		}
+	}
+
 	return c.accountID
 }
 
@@ -129,6 +183,37 @@ func (c *AWSClient) Region(ctx context.Context) string {
 	return c.awsConfig.Region
 }
 
+// overrideAssumeRole returns any per-resource IAM role ARN override defined by the currently in-process operation.
+func overrideAssumeRole(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok {
+		return inContext.OverrideAssumeRole()
+	}
+
+	return ""
+}
+
+// assumeRoleCredentialsProvider returns a credentials provider that assumes the specified IAM role
+// using the provider's configured credentials.
+// Providers are cached so that temporary credentials are shared by all resources assuming the same role.
+func (c *AWSClient) assumeRoleCredentialsProvider(roleARN string) aws.CredentialsProvider {
+	if v, ok := c.assumeRoleCredentials.Load(roleARN); ok {
+		return v.(aws.CredentialsProvider)
+	}
+
+	stsClient := sts.NewFromConfig(c.awsConfig.Copy(), func(o *sts.Options) {
+		if c.stsRegion != "" {
+			o.Region = c.stsRegion
+		}
+		if v := c.endpoints[names.STS]; v != "" {
+			o.BaseEndpoint = aws.String(v)
+		}
+	})
+	provider := aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsClient, roleARN))
+	v, _ := c.assumeRoleCredentials.LoadOrStore(roleARN, provider)
+
+	return v.(aws.CredentialsProvider)
+}
+
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
 // e.g. PREFIX.amazonaws.com
 // The prefix should not contain a trailing period.
@@ -347,14 +432,58 @@ func (c *AWSClient) ValidateInContextRegionInPartition(ctx context.Context) erro
 	return nil
 }
 
+// ValidateInContextAssumeRole verifies that the value of the top-level `assume_role` attribute is an IAM role ARN in the configured AWS partition.
+func (c *AWSClient) ValidateInContextAssumeRole(ctx context.Context) error {
+	roleARN := overrideAssumeRole(ctx)
+	if roleARN == "" {
+		return nil
+	}
+
+	v, err := arn.Parse(roleARN)
+	if err != nil {
+		return fmt.Errorf("per-resource IAM role (%s) is not a valid ARN: %w", roleARN, err)
+	}
+
+	if v.Service != "iam" || !strings.HasPrefix(v.Resource, "role/") {
+		return fmt.Errorf("per-resource IAM role (%s) is not an IAM role ARN", roleARN)
+	}
+
+	if got, want := v.Partition, c.Partition(ctx); want != "" && got != want {
+		return fmt.Errorf("partition (%s) for per-resource IAM role (%s) is not the provider's configured partition (%s)", got, roleARN, want)
+	}
+
+	return nil
+}
+
 func convertIPToDashIP(ip string) string {
 	return strings.Replace(ip, ".", "-", -1)
 }
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig := c.awsConfig
+	if roleARN := overrideAssumeRole(ctx); roleARN != "" {
+		cfg := c.AwsConfig(ctx)
+		awsConfig = &cfg
+	}
+	if c.readOnly || c.auditLog != nil || c.tracerProvider != nil {
+		cfg := awsConfig.Copy()
+		cfg.APIOptions = slices.Clone(cfg.APIOptions)
+		if c.tracerProvider != nil {
+			appendTracingAPIOptions(&cfg.APIOptions, c.tracerProvider)
+		}
+		if c.auditLog != nil {
+			// Every API call made by API clients built from this configuration is recorded, including those rejected by read-only mode.
+			cfg.APIOptions = append(cfg.APIOptions, c.auditLog.apiOption(servicePackageName, c.AccountID(ctx)))
+		}
+		if c.readOnly {
+			// Every API client built from this configuration rejects mutating operations.
+			cfg.APIOptions = append(cfg.APIOptions, readOnlyAPIOption)
+		}
+		awsConfig = &cfg
+	}
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
@@ -383,7 +512,11 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
-	region := c.Region(ctx)
+	// Default service clients are cached per Region and per-resource IAM role.
+	key := c.Region(ctx)
+	if roleARN := overrideAssumeRole(ctx); roleARN != "" {
+		key += "@" + roleARN
+	}
 
 	isDefault := len(extra) == 0
 	// Default service client is cached.
@@ -391,7 +524,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if v, ok := c.clients[region]; ok {
+		if v, ok := c.clients[key]; ok {
 			if raw, ok := v[servicePackageName]; ok {
 				if client, ok := raw.(T); ok {
 					return client, nil
@@ -425,10 +558,10 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		if _, ok := c.clients[region]; !ok {
-			c.clients[region] = make(map[string]any, 0)
+		if _, ok := c.clients[key]; !ok {
+			c.clients[key] = make(map[string]any, 0)
 		}
-		c.clients[region][servicePackageName] = client
+		c.clients[key][servicePackageName] = client
 	}
 
 	return client, nil
@@ -243,7 +243,7 @@ func TestAWSClientValidateInContextRegionInPartition(t *testing.T) { // nosemgre
 		t.Run(testCase.Name, func(t *testing.T) {
 			t.Parallel()
 
-			ctx = NewResourceContext(ctx, "test", "Test", "aws_test_test", testCase.Region)
+			ctx = NewResourceContext(ctx, "test", "Test", "aws_test_test", testCase.Region, "")
 			err := testCase.AWSClient.ValidateInContextRegionInPartition(ctx)
 
 			if got := err == nil; got != testCase.Expected {
@@ -253,6 +253,94 @@ func TestAWSClientValidateInContextRegionInPartition(t *testing.T) { // nosemgre
 	}
 }
 
+func TestAWSClientValidateInContextAssumeRole(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name      string
+		AWSClient *AWSClient
+		RoleARN   string
+		Expected  bool
+	}{
+		{
+			Name: "no override",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+			},
+			Expected: true,
+		},
+		{
+			Name: "AWS Commercial, valid",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+			},
+			RoleARN:  "arn:aws:iam::123456789012:role/spoke", //lintignore:AWSAT005
+			Expected: true,
+		},
+		{
+			Name: "AWS China, invalid",
+			AWSClient: &AWSClient{
+				partition: chinaPartition,
+			},
+			RoleARN:  "arn:aws:iam::123456789012:role/spoke", //lintignore:AWSAT005
+			Expected: false,
+		},
+		{
+			Name: "not a role",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+			},
+			RoleARN:  "arn:aws:iam::123456789012:user/spoke", //lintignore:AWSAT005
+			Expected: false,
+		},
+		{
+			Name: "not an ARN",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+			},
+			RoleARN:  "spoke",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(t.Context(), "test", "Test", "aws_test_test", "", testCase.RoleARN)
+			err := testCase.AWSClient.ValidateInContextAssumeRole(ctx)
+
+			if got := err == nil; got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAWSClientAccountID(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		accountID: "111111111111",
+	}
+
+	if got, want := client.AccountID(t.Context()), "111111111111"; got != want {
+		t.Errorf("got %s, expected %s", got, want)
+	}
+
+	ctx := NewResourceContext(t.Context(), "test", "Test", "aws_test_test", "", "arn:aws:iam::222222222222:role/spoke") //lintignore:AWSAT005
+	if got, want := client.AccountID(ctx), "222222222222"; got != want {
+		t.Errorf("got %s, expected %s", got, want)
+	}
+
+	if got, want := client.AccountIDForAssumeRole(""), "111111111111"; got != want {
+		t.Errorf("got %s, expected %s", got, want)
+	}
+	if got, want := client.AccountIDForAssumeRole("arn:aws:iam::333333333333:role/spoke"), "333333333333"; got != want { //lintignore:AWSAT005
+		t.Errorf("got %s, expected %s", got, want)
+	}
+}
+
 func TestAWSClientGlobalARN(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
@@ -32,8 +32,11 @@ type Config struct {
 	AllowedAccountIds              []string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	AuditLogFile                   string
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DefaultTimeoutsConfig          *DefaultTimeoutsConfig
+	DeletionGuardConfig            *DeletionGuardConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
@@ -46,6 +49,8 @@ type Config struct {
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	QuotaChecksConfig              *QuotaChecksConfig
+	ReadOnly                       bool
 	Region                         string
 	RetryMode                      aws.RetryMode
 	S3OriginalRegion               string
@@ -63,6 +68,7 @@ type Config struct {
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
+	TracingConfig                  *TracingConfig
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
 	UserAgent                      awsbase.UserAgentProducts
@@ -226,9 +232,34 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		c.TagPolicyConfig.RequiredTags = reqTags
 	}
 
+	if c.AuditLogFile != "" {
+		auditLog, err := openAuditLog(c.AuditLogFile)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "opening AWS API call audit log (%s): %s", c.AuditLogFile, err)
+		}
+		client.auditLog = auditLog
+	}
+
+	if c.TracingConfig != nil {
+		tracerProvider, err := newTracerProvider(ctx, c.TracingConfig, c.TerraformVersion)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		client.tracerProvider = tracerProvider
+	}
+
 	client.accountID = accountID
 	client.defaultTagsConfig = c.DefaultTagsConfig
+	client.defaultTimeoutsConfig = c.DefaultTimeoutsConfig
+	if c.DeletionGuardConfig != nil && deletionGuardOverridden() {
+		tflog.Warn(ctx, "Provider deletion_guard disabled", map[string]any{
+			"env_var": DeletionGuardOverrideEnvVar,
+		})
+	} else {
+		client.deletionGuardConfig = c.DeletionGuardConfig
+	}
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.quotaChecksConfig = c.QuotaChecksConfig
 	client.tagPolicyConfig = c.TagPolicyConfig
 	client.terraformVersion = c.TerraformVersion
 
@@ -237,6 +268,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.clients = make(map[string]map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.readOnly = c.ReadOnly
 	client.s3OriginalRegion = c.S3OriginalRegion
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
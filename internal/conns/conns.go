@@ -45,6 +45,12 @@ type ServicePackageWithSDKListResources interface {
 	SDKListResources(ctx context.Context) iter.Seq[*inttypes.ServicePackageSDKListResource]
 }
 
+// ServicePackageWithQuotaChecks is an interface that extends ServicePackage with plan-time service quota checks.
+type ServicePackageWithQuotaChecks interface {
+	ServicePackage
+	QuotaChecks(context.Context) map[string]QuotaCheck // Resource type name -> quota check.
+}
+
 type (
 	contextKeyType int
 )
@@ -55,6 +61,7 @@ var (
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
+	overrideAssumeRole string // Any currently in effect per-resource IAM role ARN override.
 	overrideRegion     string // Any currently in effect per-resource Region override.
 	resourceName       string // Friendly resource name, e.g. "Subnet"
 	typeName           string // Resource type name, e.g. "aws_iam_role"
@@ -62,6 +69,17 @@ type InContext struct {
 	vcrEnabled         bool   // Whether VCR testing is enabled
 }
 
+// OverrideAssumeRole returns any currently in effect per-resource IAM role ARN override.
+func (c *InContext) OverrideAssumeRole() string {
+	return c.overrideAssumeRole
+}
+
+// SetOverrideAssumeRole sets the per-resource IAM role ARN override.
+// It is used during import, when the value is parsed from the import ID.
+func (c *InContext) SetOverrideAssumeRole(v string) {
+	c.overrideAssumeRole = v
+}
+
 // OverrideRegion returns any currently in effect per-resource Region override.
 func (c *InContext) OverrideRegion() string {
 	return c.overrideRegion
@@ -87,8 +105,9 @@ func (c *InContext) VCREnabled() bool {
 	return c.vcrEnabled
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName, overrideRegion string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName, overrideRegion, overrideAssumeRole string) context.Context {
 	v := InContext{
+		overrideAssumeRole: overrideAssumeRole,
 		overrideRegion:     overrideRegion,
 		resourceName:       resourceName,
 		typeName:           typeName,
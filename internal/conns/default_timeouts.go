@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultTimeoutsConfig holds provider-level default resource operation timeouts.
+type DefaultTimeoutsConfig struct {
+	Rules []DefaultTimeoutsRule
+}
+
+// DefaultTimeoutsRule sets default operation timeouts for the resource types matching any of its patterns.
+type DefaultTimeoutsRule struct {
+	ResourceTypes []string // Resource type names or glob patterns, e.g. "aws_rds_*".
+	ResourceTimeouts
+}
+
+// ResourceTimeouts are a resource type's default operation timeouts.
+// A zero value means that there is no default for that operation.
+type ResourceTimeouts struct {
+	Create time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// ResourceTimeouts returns the default operation timeouts for the specified resource type.
+// For each operation the first matching rule that sets a timeout is used.
+func (c *DefaultTimeoutsConfig) ResourceTimeouts(typeName string) ResourceTimeouts {
+	var timeouts ResourceTimeouts
+
+	if c == nil {
+		return timeouts
+	}
+
+	for _, rule := range c.Rules {
+		if !matchesTypeName(rule.ResourceTypes, typeName) {
+			continue
+		}
+
+		if timeouts.Create == 0 {
+			timeouts.Create = rule.Create
+		}
+		if timeouts.Update == 0 {
+			timeouts.Update = rule.Update
+		}
+		if timeouts.Delete == 0 {
+			timeouts.Delete = rule.Delete
+		}
+	}
+
+	return timeouts
+}
+
+type defaultTimeoutsContextKeyType int
+
+var defaultTimeoutsContextKey defaultTimeoutsContextKeyType
+
+// NewDefaultTimeoutsContext returns a Context carrying a resource type's provider-level default operation timeouts.
+func NewDefaultTimeoutsContext(ctx context.Context, timeouts ResourceTimeouts) context.Context {
+	return context.WithValue(ctx, defaultTimeoutsContextKey, timeouts)
+}
+
+// DefaultTimeoutsFromContext returns any provider-level default operation timeouts in Context.
+func DefaultTimeoutsFromContext(ctx context.Context) ResourceTimeouts {
+	v, _ := ctx.Value(defaultTimeoutsContextKey).(ResourceTimeouts)
+	return v
+}
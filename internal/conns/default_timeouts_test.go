@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDefaultTimeoutsConfigResourceTimeouts(t *testing.T) {
+	t.Parallel()
+
+	config := &DefaultTimeoutsConfig{
+		Rules: []DefaultTimeoutsRule{
+			{
+				ResourceTypes:    []string{"aws_eks_node_group"},
+				ResourceTimeouts: ResourceTimeouts{Delete: 90 * time.Minute},
+			},
+			{
+				ResourceTypes:    []string{"aws_rds_*", "aws_eks_*"},
+				ResourceTimeouts: ResourceTimeouts{Create: 2 * time.Hour, Update: 2 * time.Hour, Delete: time.Hour},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		config   *DefaultTimeoutsConfig
+		typeName string
+		want     ResourceTimeouts
+	}{
+		"nil config": {
+			typeName: "aws_rds_cluster",
+		},
+		"no match": {
+			config:   config,
+			typeName: "aws_vpc",
+		},
+		"glob": {
+			config:   config,
+			typeName: "aws_rds_cluster",
+			want:     ResourceTimeouts{Create: 2 * time.Hour, Update: 2 * time.Hour, Delete: time.Hour},
+		},
+		"first match per operation": {
+			config:   config,
+			typeName: "aws_eks_node_group",
+			want:     ResourceTimeouts{Create: 2 * time.Hour, Update: 2 * time.Hour, Delete: 90 * time.Minute},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.config.ResourceTimeouts(testCase.typeName)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDefaultTimeoutsContext(t *testing.T) {
+	t.Parallel()
+
+	if got, want := DefaultTimeoutsFromContext(context.Background()), (ResourceTimeouts{}); got != want {
+		t.Errorf("DefaultTimeoutsFromContext = %v, want %v", got, want)
+	}
+
+	want := ResourceTimeouts{Create: time.Hour}
+	if got := DefaultTimeoutsFromContext(NewDefaultTimeoutsContext(context.Background(), want)); got != want {
+		t.Errorf("DefaultTimeoutsFromContext = %v, want %v", got, want)
+	}
+}
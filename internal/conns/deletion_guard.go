@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+)
+
+// DeletionGuardOverrideEnvVar is the environment variable that, if true, disables the provider's deletion guard.
+const DeletionGuardOverrideEnvVar = "TF_AWS_DELETION_GUARD_OVERRIDE"
+
+// DeletionGuardConfig selects the resources that the provider refuses to delete or destructively replace.
+type DeletionGuardConfig struct {
+	ResourceTypes []string          // Resource type names or glob patterns, e.g. "aws_rds_*".
+	Tags          map[string]string // Tags that must all be present in a resource's tags_all.
+}
+
+// Protects returns whether a resource of the specified type with the specified tags_all value is protected.
+func (c *DeletionGuardConfig) Protects(typeName string, tagsAll map[string]string) bool {
+	if c == nil {
+		return false
+	}
+
+	if matchesTypeName(c.ResourceTypes, typeName) {
+		return true
+	}
+
+	if len(c.Tags) == 0 {
+		return false
+	}
+
+	for k, v := range c.Tags {
+		if tv, ok := tagsAll[k]; !ok || tv != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeletionGuardError is returned when the provider's deletion guard refuses to delete or replace a resource.
+type DeletionGuardError struct {
+	TypeName string
+	ID       string
+	Replace  bool
+}
+
+func (e *DeletionGuardError) Error() string {
+	operation := "deletion"
+	if e.Replace {
+		operation = "replacement"
+	}
+
+	resource := e.TypeName
+	if e.ID != "" {
+		resource = fmt.Sprintf("%s (%s)", e.TypeName, e.ID)
+	}
+
+	return fmt.Sprintf("%s of %s refused by the provider's deletion_guard; set the %s environment variable to true to override", operation, resource, DeletionGuardOverrideEnvVar)
+}
+
+// matchesTypeName returns whether the specified resource type name matches any of the specified names or glob patterns.
+func matchesTypeName(patterns []string, typeName string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		ok, _ := path.Match(pattern, typeName)
+		return ok
+	})
+}
+
+// deletionGuardOverridden returns whether the deletion guard override environment variable is set to true.
+func deletionGuardOverridden() bool {
+	v, _ := strconv.ParseBool(os.Getenv(DeletionGuardOverrideEnvVar))
+	return v
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestDeletionGuardConfigProtects(t *testing.T) {
+	t.Parallel()
+
+	config := &DeletionGuardConfig{
+		ResourceTypes: []string{"aws_rds_*", "aws_s3_bucket"},
+		Tags: map[string]string{
+			"protect": "true",
+		},
+	}
+
+	testCases := map[string]struct {
+		config   *DeletionGuardConfig
+		typeName string
+		tagsAll  map[string]string
+		want     bool
+	}{
+		"nil config": {
+			typeName: "aws_rds_cluster",
+		},
+		"resource type": {
+			config:   config,
+			typeName: "aws_s3_bucket",
+			want:     true,
+		},
+		"resource type glob": {
+			config:   config,
+			typeName: "aws_rds_cluster",
+			want:     true,
+		},
+		"no match": {
+			config:   config,
+			typeName: "aws_vpc",
+			tagsAll: map[string]string{
+				"Name": "main",
+			},
+		},
+		"tags match": {
+			config:   config,
+			typeName: "aws_vpc",
+			tagsAll: map[string]string{
+				"Name":    "main",
+				"protect": "true",
+			},
+			want: true,
+		},
+		"tag value mismatch": {
+			config:   config,
+			typeName: "aws_vpc",
+			tagsAll: map[string]string{
+				"protect": "false",
+			},
+		},
+		"no tags selector": {
+			config: &DeletionGuardConfig{
+				ResourceTypes: []string{"aws_s3_bucket"},
+			},
+			typeName: "aws_vpc",
+			tagsAll: map[string]string{
+				"protect": "true",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.config.Protects(testCase.typeName, testCase.tagsAll), testCase.want; got != want {
+				t.Errorf("Protects = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestDeletionGuardOverridden(t *testing.T) {
+	testCases := map[string]struct {
+		value string
+		want  bool
+	}{
+		"unset": {},
+		"true": {
+			value: "true",
+			want:  true,
+		},
+		"false": {
+			value: "false",
+		},
+		"invalid": {
+			value: "yes please",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(DeletionGuardOverrideEnvVar, testCase.value)
+
+			if got, want := deletionGuardOverridden(), testCase.want; got != want {
+				t.Errorf("deletionGuardOverridden = %t, want %t", got, want)
+			}
+		})
+	}
+}
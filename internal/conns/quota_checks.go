@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// QuotaChecksConfig configures plan-time checks of planned resource creations against AWS service quotas.
+type QuotaChecksConfig struct {
+	Severity string // "error" or "warning".
+}
+
+// QuotaCheck describes how planned creations of a resource type are checked against an AWS service quota.
+type QuotaCheck struct {
+	QuotaName string // Human-readable quota name, e.g. "VPCs per Region".
+	// ScopeAttribute is the name of any top-level string attribute whose planned value scopes the quota, e.g. "security_group_id".
+	ScopeAttribute string
+	// Scope optionally maps the scope attribute's planned value to the key within which the quota applies,
+	// e.g. a subnet ID to its Availability Zone.
+	Scope func(ctx context.Context, c *AWSClient, value string) (string, error)
+	// Quota returns the quota value applied to the account.
+	Quota func(ctx context.Context, c *AWSClient) (float64, error)
+	// Usage returns the current usage within the scope.
+	Usage func(ctx context.Context, c *AWSClient, scope string) (int, error)
+}
+
+// QuotaExceededError is returned when planned resource creations would exceed an AWS service quota.
+type QuotaExceededError struct {
+	TypeName  string
+	QuotaName string
+	Scope     string
+	Quota     float64
+	Usage     int
+	Planned   int
+}
+
+func (e *QuotaExceededError) Error() string {
+	quotaName := e.QuotaName
+	if e.Scope != "" {
+		quotaName = fmt.Sprintf("%s (%s)", e.QuotaName, e.Scope)
+	}
+
+	return fmt.Sprintf("%d planned %s creations would exceed the %s service quota: quota %g, current usage %d", e.Planned, e.TypeName, quotaName, e.Quota, e.Usage)
+}
+
+type quotaUsage struct {
+	quota   float64
+	usage   int
+	planned int
+}
+
+// CheckQuota records the planned creation of a resource of the specified type and checks
+// current usage plus all planned creations so far against any applicable service quota.
+// getAttribute returns the planned value of a top-level string attribute and whether that value is known.
+// Quotas and usage are looked up once per provider process for each Region, account and scope.
+// Lookup failures are logged and otherwise ignored.
+func (c *AWSClient) CheckQuota(ctx context.Context, typeName string, getAttribute func(string) (string, bool)) error {
+	if c.quotaChecksConfig == nil {
+		return nil
+	}
+
+	sp, ok := c.ServicePackage(ctx, names.ServiceQuotas).(ServicePackageWithQuotaChecks)
+	if !ok {
+		return nil
+	}
+
+	check, ok := sp.QuotaChecks(ctx)[typeName]
+	if !ok {
+		return nil
+	}
+
+	var scope string
+	if check.ScopeAttribute != "" {
+		v, ok := getAttribute(check.ScopeAttribute)
+		if !ok || v == "" {
+			// The quota's scope isn't known until apply.
+			return nil
+		}
+		scope = v
+	}
+
+	if check.Scope != nil && scope != "" {
+		v, err := check.Scope(ctx, c, scope)
+		if err != nil {
+			logQuotaCheckError(ctx, typeName, check, err)
+			return nil
+		}
+		scope = v
+	}
+
+	// Quotas and usage are per Region and account, which may be overridden for the resource.
+	key := strings.Join([]string{c.Region(ctx), c.AccountID(ctx), typeName, scope}, "/")
+
+	// Quota and usage lookups are made without holding the lock.
+	// Concurrent plans may look up the same values, in which case the first recorded are used.
+	c.quotaUsageLock.Lock()
+	_, ok = c.quotaUsage[key]
+	c.quotaUsageLock.Unlock()
+
+	var lookup *quotaUsage
+	if !ok {
+		lookup = lookupQuotaUsage(ctx, c, typeName, check, scope)
+	}
+
+	c.quotaUsageLock.Lock()
+	defer c.quotaUsageLock.Unlock()
+
+	if c.quotaUsage == nil {
+		c.quotaUsage = make(map[string]*quotaUsage)
+	}
+
+	u, ok := c.quotaUsage[key]
+	if !ok {
+		u = lookup
+		c.quotaUsage[key] = u
+	}
+	if u == nil {
+		return nil
+	}
+
+	u.planned++
+	if float64(u.usage+u.planned) <= u.quota {
+		return nil
+	}
+
+	return &QuotaExceededError{
+		TypeName:  typeName,
+		QuotaName: check.QuotaName,
+		Scope:     scope,
+		Quota:     u.quota,
+		Usage:     u.usage,
+		Planned:   u.planned,
+	}
+}
+
+// lookupQuotaUsage returns a quota's value and current usage, or nil if either can't be determined.
+func lookupQuotaUsage(ctx context.Context, c *AWSClient, typeName string, check QuotaCheck, scope string) *quotaUsage {
+	quota, err := check.Quota(ctx, c)
+	if err != nil {
+		logQuotaCheckError(ctx, typeName, check, err)
+		return nil
+	}
+
+	usage, err := check.Usage(ctx, c, scope)
+	if err != nil {
+		logQuotaCheckError(ctx, typeName, check, err)
+		return nil
+	}
+
+	return &quotaUsage{
+		quota: quota,
+		usage: usage,
+	}
+}
+
+func logQuotaCheckError(ctx context.Context, typeName string, check QuotaCheck, err error) {
+	tflog.Warn(ctx, "Skipping service quota check", map[string]any{
+		"resource_type": typeName,
+		"quota":         check.QuotaName,
+		"error":         err.Error(),
+	})
+}
@@ -0,0 +1,209 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type quotaChecksServicePackage struct {
+	checks map[string]QuotaCheck
+}
+
+func (p quotaChecksServicePackage) FrameworkDataSources(context.Context) []*inttypes.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (p quotaChecksServicePackage) FrameworkResources(context.Context) []*inttypes.ServicePackageFrameworkResource {
+	return nil
+}
+
+func (p quotaChecksServicePackage) SDKDataSources(context.Context) []*inttypes.ServicePackageSDKDataSource {
+	return nil
+}
+
+func (p quotaChecksServicePackage) SDKResources(context.Context) []*inttypes.ServicePackageSDKResource {
+	return nil
+}
+
+func (p quotaChecksServicePackage) ServicePackageName() string {
+	return names.ServiceQuotas
+}
+
+func (p quotaChecksServicePackage) QuotaChecks(context.Context) map[string]QuotaCheck {
+	return p.checks
+}
+
+func TestAWSClientCheckQuota(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var lookups int
+	sp := quotaChecksServicePackage{
+		checks: map[string]QuotaCheck{
+			"aws_vpc": {
+				QuotaName: "VPCs per Region",
+				Quota: func(context.Context, *AWSClient) (float64, error) {
+					lookups++
+					return 5, nil
+				},
+				Usage: func(context.Context, *AWSClient, string) (int, error) {
+					return 3, nil
+				},
+			},
+			"aws_vpc_security_group_ingress_rule": {
+				QuotaName:      "Inbound or outbound rules per security group",
+				ScopeAttribute: "security_group_id",
+				Quota: func(context.Context, *AWSClient) (float64, error) {
+					return 2, nil
+				},
+				Usage: func(_ context.Context, _ *AWSClient, scope string) (int, error) {
+					if scope == "sg-1" {
+						return 1, nil
+					}
+					return 0, nil
+				},
+			},
+			"aws_eip": {
+				QuotaName: "EC2-VPC Elastic IPs",
+				Quota: func(context.Context, *AWSClient) (float64, error) {
+					return 0, errors.New("AccessDeniedException")
+				},
+			},
+		},
+	}
+	c := &AWSClient{
+		awsConfig:         &aws.Config{Region: "us-west-2"}, //lintignore:AWSAT003
+		quotaChecksConfig: &QuotaChecksConfig{Severity: "error"},
+		servicePackages:   map[string]ServicePackage{names.ServiceQuotas: sp},
+	}
+	getAttribute := func(v string) func(string) (string, bool) {
+		return func(string) (string, bool) {
+			return v, v != ""
+		}
+	}
+
+	// VPCs: 3 in use, quota of 5.
+	for range 2 {
+		if err := c.CheckQuota(ctx, "aws_vpc", getAttribute("")); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	var quotaErr *QuotaExceededError
+	if err := c.CheckQuota(ctx, "aws_vpc", getAttribute("")); !errors.As(err, &quotaErr) {
+		t.Fatalf("expected QuotaExceededError, got %v", err)
+	}
+	if got, want := quotaErr.Planned, 3; got != want {
+		t.Errorf("Planned = %d, want %d", got, want)
+	}
+	if got, want := lookups, 1; got != want {
+		t.Errorf("quota lookups = %d, want %d", got, want)
+	}
+
+	// Security group rules are counted per group.
+	if err := c.CheckQuota(ctx, "aws_vpc_security_group_ingress_rule", getAttribute("sg-1")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := c.CheckQuota(ctx, "aws_vpc_security_group_ingress_rule", getAttribute("sg-1")); !errors.As(err, &quotaErr) {
+		t.Fatalf("expected QuotaExceededError, got %v", err)
+	}
+	if got, want := quotaErr.Scope, "sg-1"; got != want {
+		t.Errorf("Scope = %q, want %q", got, want)
+	}
+	for range 2 {
+		if err := c.CheckQuota(ctx, "aws_vpc_security_group_ingress_rule", getAttribute("sg-2")); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	// Unknown scope.
+	for range 3 {
+		if err := c.CheckQuota(ctx, "aws_vpc_security_group_ingress_rule", getAttribute("")); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	// Lookup failures are ignored.
+	if err := c.CheckQuota(ctx, "aws_eip", getAttribute("")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// No check defined.
+	if err := c.CheckQuota(ctx, "aws_subnet", getAttribute("")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestAWSClientCheckQuota_assumeRole(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var lookups []string
+	sp := quotaChecksServicePackage{
+		checks: map[string]QuotaCheck{
+			"aws_vpc": {
+				QuotaName: "VPCs per Region",
+				Quota: func(ctx context.Context, c *AWSClient) (float64, error) {
+					lookups = append(lookups, c.AccountID(ctx))
+					return 1, nil
+				},
+				Usage: func(context.Context, *AWSClient, string) (int, error) {
+					return 0, nil
+				},
+			},
+		},
+	}
+	c := &AWSClient{
+		accountID:         "111111111111",
+		awsConfig:         &aws.Config{Region: "us-west-2"}, //lintignore:AWSAT003
+		quotaChecksConfig: &QuotaChecksConfig{Severity: "error"},
+		servicePackages:   map[string]ServicePackage{names.ServiceQuotas: sp},
+	}
+	getAttribute := func(string) (string, bool) {
+		return "", false
+	}
+	providerCtx := NewResourceContext(ctx, names.EC2, "VPC", "aws_vpc", "", "")
+	sameAccountCtx := NewResourceContext(ctx, names.EC2, "VPC", "aws_vpc", "", "arn:aws:iam::111111111111:role/hub")    //lintignore:AWSAT005
+	otherAccountCtx := NewResourceContext(ctx, names.EC2, "VPC", "aws_vpc", "", "arn:aws:iam::333333333333:role/spoke") //lintignore:AWSAT005
+
+	// Each account has its own quota of 1 VPC.
+	if err := c.CheckQuota(providerCtx, "aws_vpc", getAttribute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := c.CheckQuota(otherAccountCtx, "aws_vpc", getAttribute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var quotaErr *QuotaExceededError
+	if err := c.CheckQuota(sameAccountCtx, "aws_vpc", getAttribute); !errors.As(err, &quotaErr) {
+		t.Fatalf("expected QuotaExceededError, got %v", err)
+	}
+	if err := c.CheckQuota(otherAccountCtx, "aws_vpc", getAttribute); !errors.As(err, &quotaErr) {
+		t.Fatalf("expected QuotaExceededError, got %v", err)
+	}
+	if got, want := quotaErr.Planned, 2; got != want {
+		t.Errorf("Planned = %d, want %d", got, want)
+	}
+
+	if got, want := lookups, []string{"111111111111", "333333333333"}; !slices.Equal(got, want) {
+		t.Errorf("quota lookups = %v, want %v", got, want)
+	}
+}
+
+func TestAWSClientCheckQuota_notConfigured(t *testing.T) {
+	t.Parallel()
+
+	c := &AWSClient{}
+
+	if err := c.CheckQuota(context.Background(), "aws_vpc", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
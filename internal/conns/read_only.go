@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// readOnlyOperationPrefixes are the AWS API operation name prefixes that are classified as reads.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Search",
+}
+
+// readOnlyOperations are the AWS API operations, keyed by service ID, that don't modify resources
+// but whose names don't begin with any of readOnlyOperationPrefixes.
+var readOnlyOperations = map[string][]string{
+	"DynamoDB": {
+		"Query",
+		"Scan",
+	},
+	"IAM": {
+		"SimulateCustomPolicy",
+		"SimulatePrincipalPolicy",
+	},
+	"KMS": {
+		"Decrypt",
+	},
+	"S3": {
+		"CreateSession", // S3 Express One Zone session credentials.
+	},
+}
+
+// isReadOnlyOperation returns whether the specified AWS API operation is classified as a read.
+func isReadOnlyOperation(serviceID, operationName string) bool {
+	if slices.Contains(readOnlyOperations[serviceID], operationName) {
+		return true
+	}
+
+	for _, prefix := range readOnlyOperationPrefixes {
+		if v, ok := strings.CutPrefix(operationName, prefix); ok && (v == "" || v[0] >= 'A' && v[0] <= 'Z') {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReadOnlyOperationError is returned for AWS API operations blocked by the provider's read-only mode.
+type ReadOnlyOperationError struct {
+	ServiceID     string
+	OperationName string
+}
+
+func (e *ReadOnlyOperationError) Error() string {
+	// The AWS SDK for Go v2 wraps this error with the service ID and operation name.
+	return "operation is not a read and the provider is configured with read_only = true"
+}
+
+// readOnlyAPIOption is an AWS SDK for Go v2 API option that rejects any operation not classified as a read.
+func readOnlyAPIOption(stack *middleware.Stack) error {
+	return stack.Initialize.Add(
+		middleware.InitializeMiddlewareFunc(
+			"ReadOnly",
+			func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+				if serviceID, operationName := awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx); !isReadOnlyOperation(serviceID, operationName) {
+					return middleware.InitializeOutput{}, middleware.Metadata{}, &ReadOnlyOperationError{
+						ServiceID:     serviceID,
+						OperationName: operationName,
+					}
+				}
+
+				return next.HandleInitialize(ctx, in)
+			},
+		),
+		middleware.After, // Operation metadata is registered by the client's own Initialize middleware.
+	)
+}
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		serviceID     string
+		operationName string
+		want          bool
+	}{
+		"BatchGetItem":                {serviceID: "DynamoDB", operationName: "BatchGetItem", want: true},
+		"DescribeInstances":           {serviceID: "EC2", operationName: "DescribeInstances", want: true},
+		"GetCallerIdentity":           {serviceID: "STS", operationName: "GetCallerIdentity", want: true},
+		"HeadObject":                  {serviceID: "S3", operationName: "HeadObject", want: true},
+		"ListBuckets":                 {serviceID: "S3", operationName: "ListBuckets", want: true},
+		"LookupEvents":                {serviceID: "CloudTrail", operationName: "LookupEvents", want: true},
+		"SearchResources":             {serviceID: "Resource Groups", operationName: "SearchResources", want: true},
+		"DynamoDB Query":              {serviceID: "DynamoDB", operationName: "Query", want: true},
+		"DynamoDB Scan":               {serviceID: "DynamoDB", operationName: "Scan", want: true},
+		"IAM SimulatePrincipalPolicy": {serviceID: "IAM", operationName: "SimulatePrincipalPolicy", want: true},
+		"KMS Decrypt":                 {serviceID: "KMS", operationName: "Decrypt", want: true},
+		"S3 CreateSession":            {serviceID: "S3", operationName: "CreateSession", want: true},
+		"CreateVpc":                   {serviceID: "EC2", operationName: "CreateVpc"},
+		"DeleteBucket":                {serviceID: "S3", operationName: "DeleteBucket"},
+		"PutObject":                   {serviceID: "S3", operationName: "PutObject"},
+		"ModifyInstanceAttr":          {serviceID: "EC2", operationName: "ModifyInstanceAttr"},
+		"Getaway":                     {serviceID: "EC2", operationName: "Getaway"},
+		"Listen":                      {serviceID: "EC2", operationName: "Listen"},
+		"KMS Encrypt":                 {serviceID: "KMS", operationName: "Encrypt"},
+		"Query other service":         {serviceID: "Timestream Query", operationName: "Query"},
+		"Decrypt other service":       {serviceID: "EC2", operationName: "Decrypt"},
+		"empty":                       {},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := isReadOnlyOperation(testCase.serviceID, testCase.operationName), testCase.want; got != want {
+				t.Errorf("isReadOnlyOperation(%q, %q) = %t, want %t", testCase.serviceID, testCase.operationName, got, want)
+			}
+		})
+	}
+}
+
+func TestReadOnlyAPIOption(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		serviceID     string
+		operationName string
+		wantErr       bool
+	}{
+		"read": {
+			serviceID:     "EC2",
+			operationName: "DescribeVpcs",
+		},
+		"allowlisted read": {
+			serviceID:     "DynamoDB",
+			operationName: "Scan",
+		},
+		"mutation": {
+			serviceID:     "EC2",
+			operationName: "CreateVpc",
+			wantErr:       true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			stack := middleware.NewStack(testCase.operationName, func() any { return nil })
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+				ServiceID:     testCase.serviceID,
+				OperationName: testCase.operationName,
+			}, middleware.Before); err != nil {
+				t.Fatal(err)
+			}
+			if err := readOnlyAPIOption(stack); err != nil {
+				t.Fatal(err)
+			}
+
+			var called bool
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+				called = true
+				return nil, middleware.Metadata{}, nil
+			}), stack)
+
+			_, _, err := handler.Handle(context.Background(), nil)
+
+			if testCase.wantErr {
+				var target *ReadOnlyOperationError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected ReadOnlyOperationError, got %v", err)
+				}
+				if got, want := target.OperationName, testCase.operationName; got != want {
+					t.Errorf("OperationName = %q, want %q", got, want)
+				}
+				if called {
+					t.Error("operation was sent")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if !called {
+					t.Error("operation was not sent")
+				}
+			}
+		})
+	}
+}
@@ -4,9 +4,15 @@
 package conns
 
 import (
+	"github.com/aws/aws-sdk-go-v2/aws"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
+// SetAWSConfig is only intended for use in tests
+func SetAWSConfig(client *AWSClient, cfg *aws.Config) {
+	client.awsConfig = cfg
+}
+
 // SetDefaultTagsConfig is only intended for use in tests
 func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 	client.defaultTagsConfig = d
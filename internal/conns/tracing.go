@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.38.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// TracerName is the name of the OpenTelemetry tracer used for provider spans.
+const TracerName = "github.com/hashicorp/terraform-provider-aws"
+
+// TracingConfig configures OpenTelemetry tracing of provider operations and AWS API calls.
+type TracingConfig struct {
+	File         string // Path of a file to which spans are written as OTLP JSON Lines.
+	OTLPEndpoint string // URL of an OTLP/HTTP collector endpoint.
+}
+
+var (
+	// tracerProviders holds the tracer providers created by provider configuration, which are shut down by ShutdownTracing.
+	tracerProviders   []*sdktrace.TracerProvider
+	tracerProvidersMu sync.Mutex
+)
+
+// newTracerProvider returns an OpenTelemetry tracer provider that exports spans as configured.
+// Spans are batched; they are flushed at the end of each provider operation by FlushSpans.
+func newTracerProvider(ctx context.Context, config *TracingConfig, terraformVersion string) (*sdktrace.TracerProvider, error) {
+	var opts []sdktrace.TracerProviderOption
+
+	if config.OTLPEndpoint != "" {
+		exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(config.OTLPEndpoint))
+		if err != nil {
+			return nil, fmt.Errorf("creating OTLP trace exporter: %w", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+
+	if config.File != "" {
+		f, err := os.OpenFile(config.File, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("opening trace file (%s): %w", config.File, err)
+		}
+		exporter, err := otlptrace.New(ctx, &otlpFileClient{f: f})
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("creating file trace exporter: %w", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+
+	opts = append(opts, sdktrace.WithResource(resource.NewSchemaless(
+		semconv.ServiceName("terraform-provider-aws"),
+		attribute.String("terraform.version", terraformVersion),
+	)))
+
+	tracerProvider := sdktrace.NewTracerProvider(opts...)
+
+	tracerProvidersMu.Lock()
+	tracerProviders = append(tracerProviders, tracerProvider)
+	tracerProvidersMu.Unlock()
+
+	return tracerProvider, nil
+}
+
+// ShutdownTracing flushes any batched spans, shuts down all tracer providers and closes any trace files.
+// It is called when the provider server stops.
+func ShutdownTracing(ctx context.Context) error {
+	tracerProvidersMu.Lock()
+	defer tracerProvidersMu.Unlock()
+
+	var errs []error
+	for _, tracerProvider := range tracerProviders {
+		errs = append(errs, tracerProvider.Shutdown(ctx))
+	}
+	tracerProviders = nil
+
+	return errors.Join(errs...)
+}
+
+// otlpFileClient is an OTLP trace exporter client that writes spans to a file
+// in the OTLP JSON Lines format, one TracesData message per line.
+// See https://opentelemetry.io/docs/specs/otel/protocol/file-exporter/.
+type otlpFileClient struct {
+	f    *os.File
+	lock sync.Mutex
+}
+
+func (c *otlpFileClient) Start(context.Context) error {
+	return nil
+}
+
+func (c *otlpFileClient) Stop(context.Context) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.f.Close()
+}
+
+func (c *otlpFileClient) UploadTraces(_ context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	b, err := protojson.Marshal(&tracepb.TracesData{ResourceSpans: protoSpans})
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	_, err = c.f.Write(b)
+
+	return err
+}
+
+// Tracer returns the OpenTelemetry tracer used for provider spans.
+// A no-op tracer is returned if tracing is not configured.
+func (c *AWSClient) Tracer(_ context.Context) trace.Tracer {
+	if c == nil || c.tracerProvider == nil {
+		return noop.NewTracerProvider().Tracer(TracerName)
+	}
+
+	return c.tracerProvider.Tracer(TracerName)
+}
+
+// FlushSpans exports any batched spans.
+// It is called at the end of each provider RPC so that spans are exported before Terraform stops the provider.
+func (c *AWSClient) FlushSpans(ctx context.Context) {
+	if c == nil || c.tracerProvider == nil {
+		return
+	}
+
+	if err := c.tracerProvider.ForceFlush(ctx); err != nil {
+		tflog.Warn(ctx, "Flushing trace spans", map[string]any{
+			"error": err.Error(),
+		})
+	}
+}
+
+// StartSpan starts a span for the specified provider operation (e.g. "Create") on the resource in context.
+func (c *AWSClient) StartSpan(ctx context.Context, operation string) (context.Context, trace.Span) {
+	name := operation
+	attrs := []attribute.KeyValue{
+		attribute.String("terraform.operation", operation),
+	}
+	if inContext, ok := FromContext(ctx); ok {
+		if v := inContext.TypeName(); v != "" {
+			name = v + "." + operation
+			attrs = append(attrs, attribute.String("terraform.resource_type", v))
+		}
+		attrs = append(attrs, attribute.String("aws.service_package", inContext.ServicePackageName()))
+	}
+	if c != nil {
+		attrs = append(attrs, attribute.String("aws.region", c.Region(ctx)))
+	}
+
+	return c.Tracer(ctx).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// appendTracingAPIOptions adds AWS SDK for Go v2 API options that create a span per API call and a child span per attempt.
+func appendTracingAPIOptions(apiOptions *[]func(*middleware.Stack) error, tracerProvider trace.TracerProvider) {
+	otelaws.AppendMiddlewares(apiOptions, otelaws.WithTracerProvider(tracerProvider))
+	*apiOptions = append(*apiOptions, func(stack *middleware.Stack) error {
+		var attempt int
+
+		return stack.Finalize.Add(
+			middleware.FinalizeMiddlewareFunc(
+				"OTelAttemptSpan",
+				func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+					attempt++
+					ctx, span := tracerProvider.Tracer(TracerName).Start(ctx, "attempt",
+						trace.WithSpanKind(trace.SpanKindClient),
+						trace.WithAttributes(attribute.Int("aws.attempt", attempt)),
+					)
+					defer span.End()
+
+					out, metadata, err := next.HandleFinalize(ctx, in)
+					if err != nil {
+						span.RecordError(err)
+						span.SetStatus(codes.Error, err.Error())
+					}
+
+					return out, metadata, err
+				},
+			),
+			middleware.After, // Runs once per attempt, after the retry middleware.
+		)
+	})
+}
@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestAWSClientStartSpan(t *testing.T) {
+	t.Parallel()
+
+	exporter := tracetest.NewInMemoryExporter()
+	c := &AWSClient{tracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))}
+
+	ctx := NewResourceContext(context.Background(), "ec2", "VPC", "aws_vpc", "us-west-2", "") //lintignore:AWSAT003
+	_, span := c.StartSpan(ctx, "Create")
+	span.End()
+
+	spans := exporter.GetSpans()
+	if got, want := len(spans), 1; got != want {
+		t.Fatalf("len(spans) = %d, want %d", got, want)
+	}
+	if got, want := spans[0].Name, "aws_vpc.Create"; got != want {
+		t.Errorf("Name = %q, want %q", got, want)
+	}
+
+	attrs := attribute.NewSet(spans[0].Attributes...)
+	for k, want := range map[attribute.Key]string{
+		"terraform.operation":     "Create",
+		"terraform.resource_type": "aws_vpc",
+		"aws.service_package":     "ec2",
+		"aws.region":              "us-west-2", //lintignore:AWSAT003
+	} {
+		if v, ok := attrs.Value(k); !ok || v.AsString() != want {
+			t.Errorf("attribute %s = %q, want %q", k, v.AsString(), want)
+		}
+	}
+}
+
+func TestAWSClientStartSpan_notConfigured(t *testing.T) {
+	t.Parallel()
+
+	var c *AWSClient
+
+	_, span := c.StartSpan(context.Background(), "Read")
+	defer span.End()
+
+	if span.IsRecording() {
+		t.Error("span is recording")
+	}
+}
+
+func TestNewTracerProvider_file(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+
+	tracerProvider, err := newTracerProvider(ctx, &TracingConfig{File: path}, "1.14.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &AWSClient{tracerProvider: tracerProvider}
+
+	_, span := c.StartSpan(NewResourceContext(ctx, "ec2", "VPC", "aws_vpc", "us-west-2", ""), "Read") //lintignore:AWSAT003
+	span.End()
+	c.FlushSpans(ctx)
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := bytes.Split(bytes.TrimSpace(b), []byte("\n"))
+	if got, want := len(lines), 1; got != want {
+		t.Fatalf("len(lines) = %d, want %d", got, want)
+	}
+
+	var data tracepb.TracesData
+	if err := protojson.Unmarshal(lines[0], &data); err != nil {
+		t.Fatalf("unmarshaling OTLP JSON: %s", err)
+	}
+	if got, want := data.GetResourceSpans()[0].GetScopeSpans()[0].GetSpans()[0].GetName(), "aws_vpc.Read"; got != want {
+		t.Errorf("Name = %q, want %q", got, want)
+	}
+
+	if err := tracerProvider.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAppendTracingAPIOptions(t *testing.T) {
+	t.Parallel()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	var apiOptions []func(*middleware.Stack) error
+	appendTracingAPIOptions(&apiOptions, tp)
+
+	stack := middleware.NewStack("DescribeVpcs", func() any { return nil })
+	if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+		ServiceID:     "EC2",
+		OperationName: "DescribeVpcs",
+		Region:        "us-west-2", //lintignore:AWSAT003
+	}, middleware.Before); err != nil {
+		t.Fatal(err)
+	}
+	for _, apiOption := range apiOptions {
+		if err := apiOption(stack); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	wantErr := errors.New("test")
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+		return nil, middleware.Metadata{}, wantErr
+	}), stack)
+
+	if _, _, err := handler.Handle(context.Background(), nil); !errors.Is(err, wantErr) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	spans := exporter.GetSpans()
+	if got, want := len(spans), 2; got != want {
+		t.Fatalf("len(spans) = %d, want %d", got, want)
+	}
+
+	// Spans are exported as they end, children first.
+	attempt, call := spans[0], spans[1]
+	if got, want := attempt.Name, "attempt"; got != want {
+		t.Errorf("attempt span Name = %q, want %q", got, want)
+	}
+	if got, want := attempt.Parent.SpanID(), call.SpanContext.SpanID(); got != want {
+		t.Errorf("attempt span parent = %s, want %s", got, want)
+	}
+	if got, want := attempt.Status.Code, codes.Error; got != want {
+		t.Errorf("attempt span status = %s, want %s", got, want)
+	}
+	attrs := attribute.NewSet(attempt.Attributes...)
+	if v, ok := attrs.Value("aws.attempt"); !ok || v.AsInt64() != 1 {
+		t.Errorf("attempt span aws.attempt = %d, want 1", v.AsInt64())
+	}
+	if got, want := call.Name, "EC2.DescribeVpcs"; got != want {
+		t.Errorf("API call span Name = %q, want %q", got, want)
+	}
+}
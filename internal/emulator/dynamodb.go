@@ -0,0 +1,502 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+type dynamoDBTable struct {
+	description map[string]any // TableDescription.
+	pitr        bool
+	ttl         map[string]any // TimeToLiveSpecification.
+	tags        map[string]string
+	items       map[string]map[string]any // Keyed by encoded primary key.
+}
+
+// key returns the encoded primary key of an item.
+func (t *dynamoDBTable) key(item map[string]any) (string, error) {
+	var key []any
+
+	keySchema, _ := t.description["KeySchema"].([]any)
+	for _, v := range keySchema {
+		name := inputString(v.(map[string]any), "AttributeName")
+		value, ok := item[name]
+		if !ok {
+			return "", NewError("ValidationException", "One of the required keys was not given a value")
+		}
+		key = append(key, value)
+	}
+
+	b, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+type dynamoDBState struct {
+	mu     sync.Mutex
+	tables map[string]*dynamoDBTable // Keyed by table name.
+}
+
+func (st *dynamoDBState) table(nameOrARN string) (*dynamoDBTable, error) {
+	name := nameOrARN[strings.LastIndex(nameOrARN, "/")+1:]
+	if table, ok := st.tables[name]; ok {
+		return table, nil
+	}
+
+	return nil, NewError("ResourceNotFoundException", "Requested resource not found: Table: %s not found", name)
+}
+
+// registerDynamoDB registers handlers for the Amazon DynamoDB AWS JSON 1.0 protocol API.
+func registerDynamoDB(s *Server) {
+	const service = "dynamodb"
+	st := &dynamoDBState{
+		tables: make(map[string]*dynamoDBTable),
+	}
+
+	s.Register(service, "CreateTable", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		name := inputString(request.Input, "TableName")
+		if _, ok := st.tables[name]; ok {
+			return nil, NewError("ResourceInUseException", "Table already exists: %s", name)
+		}
+
+		arn := arn(service, request.Region, "table/"+name)
+		billingMode := inputString(request.Input, "BillingMode")
+		if billingMode == "" {
+			billingMode = "PROVISIONED"
+		}
+
+		description := map[string]any{
+			"AttributeDefinitions":      request.Input["AttributeDefinitions"],
+			"BillingModeSummary":        map[string]any{"BillingMode": billingMode},
+			"CreationDateTime":          epochSeconds(time.Now()),
+			"DeletionProtectionEnabled": inputBool(request.Input, "DeletionProtectionEnabled"),
+			"ItemCount":                 0,
+			"KeySchema":                 request.Input["KeySchema"],
+			"ProvisionedThroughput":     dynamoDBProvisionedThroughput(request.Input["ProvisionedThroughput"]),
+			"TableArn":                  arn,
+			"TableId":                   randomID(),
+			"TableName":                 name,
+			"TableSizeBytes":            0,
+			"TableStatus":               "ACTIVE",
+		}
+		if v := inputString(request.Input, "TableClass"); v != "" {
+			description["TableClassSummary"] = map[string]any{"TableClass": v}
+		}
+		if v, ok := request.Input["GlobalSecondaryIndexes"].([]any); ok {
+			description["GlobalSecondaryIndexes"] = dynamoDBIndexes(arn, v, true)
+		}
+		if v, ok := request.Input["LocalSecondaryIndexes"].([]any); ok {
+			description["LocalSecondaryIndexes"] = dynamoDBIndexes(arn, v, false)
+		}
+		if v, ok := request.Input["SSESpecification"].(map[string]any); ok && inputBool(v, "Enabled") {
+			description["SSEDescription"] = map[string]any{
+				"KMSMasterKeyArn": inputString(v, "KMSMasterKeyId"),
+				"SSEType":         "KMS",
+				"Status":          "ENABLED",
+			}
+		}
+		dynamoDBSetStreamSpecification(description, request.Input["StreamSpecification"])
+
+		st.tables[name] = &dynamoDBTable{
+			description: description,
+			ttl:         map[string]any{"TimeToLiveStatus": "DISABLED"},
+			tags:        inputTags(request.Input, "Tags", "Key", "Value"),
+			items:       make(map[string]map[string]any),
+		}
+
+		return map[string]any{"TableDescription": deepCopy(description)}, nil
+	})
+
+	s.Register(service, "DescribeTable", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		table, err := st.table(inputString(request.Input, "TableName"))
+		if err != nil {
+			return nil, err
+		}
+
+		table.description["ItemCount"] = len(table.items)
+
+		return map[string]any{"Table": deepCopy(table.description)}, nil
+	})
+
+	s.Register(service, "UpdateTable", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		table, err := st.table(inputString(request.Input, "TableName"))
+		if err != nil {
+			return nil, err
+		}
+
+		description := table.description
+		if v := inputString(request.Input, "BillingMode"); v != "" {
+			description["BillingModeSummary"] = map[string]any{"BillingMode": v}
+		}
+		if v, ok := request.Input["DeletionProtectionEnabled"].(bool); ok {
+			description["DeletionProtectionEnabled"] = v
+		}
+		if v, ok := request.Input["ProvisionedThroughput"]; ok {
+			description["ProvisionedThroughput"] = dynamoDBProvisionedThroughput(v)
+		}
+		if v := inputString(request.Input, "TableClass"); v != "" {
+			description["TableClassSummary"] = map[string]any{"TableClass": v}
+		}
+		if v, ok := request.Input["AttributeDefinitions"]; ok {
+			description["AttributeDefinitions"] = v
+		}
+		dynamoDBSetStreamSpecification(description, request.Input["StreamSpecification"])
+
+		updates, _ := request.Input["GlobalSecondaryIndexUpdates"].([]any)
+		for _, v := range updates {
+			update, _ := v.(map[string]any)
+			indexes, _ := description["GlobalSecondaryIndexes"].([]any)
+
+			if v, ok := update["Create"].([]any); ok {
+				indexes = append(indexes, dynamoDBIndexes(inputString(description, "TableArn"), v, true)...)
+			} else if v, ok := update["Create"].(map[string]any); ok {
+				indexes = append(indexes, dynamoDBIndexes(inputString(description, "TableArn"), []any{v}, true)...)
+			}
+			if v, ok := update["Delete"].(map[string]any); ok {
+				indexes = slices.DeleteFunc(indexes, func(index any) bool {
+					return inputString(index.(map[string]any), "IndexName") == inputString(v, "IndexName")
+				})
+			}
+			if v, ok := update["Update"].(map[string]any); ok {
+				for _, index := range indexes {
+					if index := index.(map[string]any); inputString(index, "IndexName") == inputString(v, "IndexName") {
+						index["ProvisionedThroughput"] = dynamoDBProvisionedThroughput(v["ProvisionedThroughput"])
+					}
+				}
+			}
+
+			if len(indexes) == 0 {
+				delete(description, "GlobalSecondaryIndexes")
+			} else {
+				description["GlobalSecondaryIndexes"] = indexes
+			}
+		}
+
+		return map[string]any{"TableDescription": deepCopy(description)}, nil
+	})
+
+	s.Register(service, "DeleteTable", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		table, err := st.table(inputString(request.Input, "TableName"))
+		if err != nil {
+			return nil, err
+		}
+
+		if inputBool(table.description, "DeletionProtectionEnabled") {
+			return nil, NewError("ValidationException", "Resource cannot be deleted as it is currently protected against deletion. Disable deletion protection first.")
+		}
+
+		delete(st.tables, inputString(table.description, "TableName"))
+
+		description := deepCopy(table.description)
+		description["TableStatus"] = "DELETING"
+
+		return map[string]any{"TableDescription": description}, nil
+	})
+
+	s.Register(service, "ListTables", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		start := inputString(request.Input, "ExclusiveStartTableName")
+		names := make([]string, 0)
+		for _, name := range sortedKeys(st.tables) {
+			if name > start {
+				names = append(names, name)
+			}
+		}
+
+		return map[string]any{"TableNames": names}, nil
+	})
+
+	s.Register(service, "DescribeContinuousBackups", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		table, err := st.table(inputString(request.Input, "TableName"))
+		if err != nil {
+			return nil, dynamoDBTableNotFoundError(err)
+		}
+
+		return map[string]any{"ContinuousBackupsDescription": dynamoDBContinuousBackupsDescription(table.pitr)}, nil
+	})
+
+	s.Register(service, "UpdateContinuousBackups", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		table, err := st.table(inputString(request.Input, "TableName"))
+		if err != nil {
+			return nil, dynamoDBTableNotFoundError(err)
+		}
+
+		if v, ok := request.Input["PointInTimeRecoverySpecification"].(map[string]any); ok {
+			table.pitr = inputBool(v, "PointInTimeRecoveryEnabled")
+		}
+
+		return map[string]any{"ContinuousBackupsDescription": dynamoDBContinuousBackupsDescription(table.pitr)}, nil
+	})
+
+	s.Register(service, "DescribeTimeToLive", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		table, err := st.table(inputString(request.Input, "TableName"))
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]any{"TimeToLiveDescription": maps.Clone(table.ttl)}, nil
+	})
+
+	s.Register(service, "UpdateTimeToLive", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		table, err := st.table(inputString(request.Input, "TableName"))
+		if err != nil {
+			return nil, err
+		}
+
+		specification, _ := request.Input["TimeToLiveSpecification"].(map[string]any)
+		if inputBool(specification, "Enabled") {
+			table.ttl = map[string]any{
+				"AttributeName":    inputString(specification, "AttributeName"),
+				"TimeToLiveStatus": "ENABLED",
+			}
+		} else {
+			table.ttl = map[string]any{"TimeToLiveStatus": "DISABLED"}
+		}
+
+		return map[string]any{"TimeToLiveSpecification": specification}, nil
+	})
+
+	s.Register(service, "ListTagsOfResource", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		table, err := st.table(inputString(request.Input, "ResourceArn"))
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]any{"Tags": outputTags(table.tags, "Key", "Value")}, nil
+	})
+
+	s.Register(service, "TagResource", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		table, err := st.table(inputString(request.Input, "ResourceArn"))
+		if err != nil {
+			return nil, err
+		}
+
+		maps.Copy(table.tags, inputTags(request.Input, "Tags", "Key", "Value"))
+
+		return nil, nil
+	})
+
+	s.Register(service, "UntagResource", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		table, err := st.table(inputString(request.Input, "ResourceArn"))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, k := range inputStrings(request.Input, "TagKeys") {
+			delete(table.tags, k)
+		}
+
+		return nil, nil
+	})
+
+	s.Register(service, "PutItem", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		table, err := st.table(inputString(request.Input, "TableName"))
+		if err != nil {
+			return nil, err
+		}
+
+		item, _ := request.Input["Item"].(map[string]any)
+		key, err := table.key(item)
+		if err != nil {
+			return nil, err
+		}
+
+		output := make(map[string]any)
+		if old, ok := table.items[key]; ok && inputString(request.Input, "ReturnValues") == "ALL_OLD" {
+			output["Attributes"] = old
+		}
+		table.items[key] = item
+
+		return output, nil
+	})
+
+	s.Register(service, "GetItem", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		table, err := st.table(inputString(request.Input, "TableName"))
+		if err != nil {
+			return nil, err
+		}
+
+		v, _ := request.Input["Key"].(map[string]any)
+		key, err := table.key(v)
+		if err != nil {
+			return nil, err
+		}
+
+		output := make(map[string]any)
+		if item, ok := table.items[key]; ok {
+			output["Item"] = item
+		}
+
+		return output, nil
+	})
+
+	s.Register(service, "DeleteItem", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		table, err := st.table(inputString(request.Input, "TableName"))
+		if err != nil {
+			return nil, err
+		}
+
+		v, _ := request.Input["Key"].(map[string]any)
+		key, err := table.key(v)
+		if err != nil {
+			return nil, err
+		}
+
+		output := make(map[string]any)
+		if old, ok := table.items[key]; ok && inputString(request.Input, "ReturnValues") == "ALL_OLD" {
+			output["Attributes"] = old
+		}
+		delete(table.items, key)
+
+		return output, nil
+	})
+
+	s.Register(service, "Scan", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		table, err := st.table(inputString(request.Input, "TableName"))
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]any, 0, len(table.items))
+		for _, key := range sortedKeys(table.items) {
+			items = append(items, table.items[key])
+		}
+
+		return map[string]any{
+			"Count":        len(items),
+			"Items":        items,
+			"ScannedCount": len(items),
+		}, nil
+	})
+}
+
+func dynamoDBTableNotFoundError(err error) error {
+	return NewError("TableNotFoundException", "%s", err.(*Error).Message)
+}
+
+func dynamoDBProvisionedThroughput(v any) map[string]any {
+	throughput := map[string]any{
+		"NumberOfDecreasesToday": 0,
+		"ReadCapacityUnits":      0,
+		"WriteCapacityUnits":     0,
+	}
+
+	if v, ok := v.(map[string]any); ok {
+		for _, k := range []string{"ReadCapacityUnits", "WriteCapacityUnits"} {
+			if v, ok := v[k]; ok {
+				throughput[k] = v
+			}
+		}
+	}
+
+	return throughput
+}
+
+func dynamoDBIndexes(tableARN string, tfList []any, global bool) []any {
+	var indexes []any
+
+	for _, v := range tfList {
+		index := maps.Clone(v.(map[string]any))
+		index["IndexArn"] = tableARN + "/index/" + inputString(index, "IndexName")
+		index["IndexSizeBytes"] = 0
+		index["ItemCount"] = 0
+		if global {
+			index["IndexStatus"] = "ACTIVE"
+			index["ProvisionedThroughput"] = dynamoDBProvisionedThroughput(index["ProvisionedThroughput"])
+		}
+		indexes = append(indexes, index)
+	}
+
+	return indexes
+}
+
+func dynamoDBSetStreamSpecification(description map[string]any, v any) {
+	specification, ok := v.(map[string]any)
+	if !ok {
+		return
+	}
+
+	if !inputBool(specification, "StreamEnabled") {
+		delete(description, "LatestStreamArn")
+		delete(description, "LatestStreamLabel")
+		description["StreamSpecification"] = map[string]any{"StreamEnabled": false}
+		return
+	}
+
+	label := time.Now().UTC().Format("2006-01-02T15:04:05.000")
+	description["LatestStreamArn"] = inputString(description, "TableArn") + "/stream/" + label
+	description["LatestStreamLabel"] = label
+	description["StreamSpecification"] = specification
+}
+
+func dynamoDBContinuousBackupsDescription(pitr bool) map[string]any {
+	status := "DISABLED"
+	if pitr {
+		status = "ENABLED"
+	}
+
+	return map[string]any{
+		"ContinuousBackupsStatus": "ENABLED",
+		"PointInTimeRecoveryDescription": map[string]any{
+			"PointInTimeRecoveryStatus": status,
+		},
+	}
+}
@@ -0,0 +1,387 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package emulator provides an in-process emulator of a subset of AWS service
+// APIs, allowing acceptance tests to run without an AWS account.
+//
+// A Server routes each request to a handler registered for the AWS service and
+// operation. The service is the Signature Version 4 signing name from the
+// request's Authorization header. The operation is determined by the request's
+// protocol:
+//
+//   - AWS JSON 1.0 and 1.1: the X-Amz-Target header
+//   - AWS Query: the Action form parameter
+//   - REST: the OperationResolver registered for the service
+//
+// Built-in handlers keep in-memory state for common CRUD operations of the
+// services returned by Services.
+package emulator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+const (
+	// AccountID is the AWS account ID of the emulated caller.
+	AccountID = "123456789012"
+
+	// AccessKeyID and SecretAccessKey are static credentials accepted by the emulator.
+	// Request signatures are not verified.
+	AccessKeyID     = "AKIAEMULATOREXAMPLE"
+	SecretAccessKey = "emulator/secret/access/key/EXAMPLE"
+
+	// DefaultRegion is used when a request's region cannot be determined.
+	DefaultRegion = "us-west-2"
+)
+
+// Protocol is an AWS service protocol.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+type Protocol int
+
+const (
+	ProtocolAWSJSON10 Protocol = iota
+	ProtocolAWSJSON11
+	ProtocolAWSQuery
+	ProtocolREST
+)
+
+// Request is a request to an emulated AWS service operation.
+type Request struct {
+	Service   string
+	Operation string
+	Region    string
+	Protocol  Protocol
+
+	// HTTP is the underlying HTTP request. Its body has been read into Body.
+	HTTP *http.Request
+	Body []byte
+
+	// Input is the decoded request body for AWS JSON protocol requests.
+	Input map[string]any
+
+	// Form is the decoded request body for AWS Query protocol requests.
+	Form url.Values
+}
+
+// HandlerFunc handles a request to an emulated AWS service operation.
+//
+// For AWS JSON protocols the returned output is encoded as JSON.
+// For the AWS Query protocol the output is encoded as the XML <OperationResult> element.
+// For REST protocols the output is encoded as an XML document.
+// A *Response output is written as-is for all protocols.
+//
+// Returned errors of type *Error are encoded as service errors for the request's protocol.
+type HandlerFunc func(ctx context.Context, request *Request) (any, error)
+
+// OperationResolver returns the operation name for a request to a REST protocol
+// service, or an empty string if the request does not match a known operation.
+type OperationResolver func(r *http.Request) string
+
+// Response is a raw HTTP response returned from a HandlerFunc.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Error is an AWS service error.
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+
+	// QueryErrorCode is the AWS Query compatible error code returned from
+	// services that have migrated from the AWS Query protocol, e.g. SQS.
+	QueryErrorCode string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// NewError returns a new client (HTTP 400) service error.
+func NewError(code, format string, a ...any) *Error {
+	return &Error{
+		StatusCode: http.StatusBadRequest,
+		Code:       code,
+		Message:    fmt.Sprintf(format, a...),
+	}
+}
+
+// Server is an in-process emulator of AWS service APIs.
+type Server struct {
+	server *httptest.Server
+
+	mu        sync.RWMutex
+	handlers  map[string]map[string]HandlerFunc
+	resolvers map[string]OperationResolver
+}
+
+// New starts a new Server with built-in handlers for the specified services.
+// If no services are specified, built-in handlers for all services are registered.
+// The caller must call Close when finished to shut the server down.
+func New(services ...string) (*Server, error) {
+	if len(services) == 0 {
+		services = Services()
+	}
+
+	s := &Server{
+		handlers:  make(map[string]map[string]HandlerFunc),
+		resolvers: make(map[string]OperationResolver),
+	}
+
+	for _, service := range services {
+		register, ok := builtinServices[service]
+		if !ok {
+			return nil, fmt.Errorf("emulator: unsupported service %q", service)
+		}
+		register(s)
+	}
+
+	s.server = httptest.NewServer(s)
+
+	return s, nil
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// URL returns the server's base URL, for use as an AWS service endpoint.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Register registers the handler for the specified service and operation,
+// replacing any existing handler.
+func (s *Server) Register(service, operation string, handler HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.handlers[service] == nil {
+		s.handlers[service] = make(map[string]HandlerFunc)
+	}
+	s.handlers[service][operation] = handler
+}
+
+// RegisterResolver registers the operation resolver for the specified REST protocol service.
+func (s *Server) RegisterResolver(service string, resolver OperationResolver) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.resolvers[service] = resolver
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	request, err := s.newRequest(r)
+	if err != nil {
+		writeError(w, request, err)
+		return
+	}
+
+	s.mu.RLock()
+	handler, ok := s.handlers[request.Service][request.Operation]
+	s.mu.RUnlock()
+
+	if !ok {
+		writeError(w, request, &Error{
+			StatusCode: http.StatusNotImplemented,
+			Code:       "NotImplemented",
+			Message:    fmt.Sprintf("emulator: %s operation %q is not implemented", request.Service, request.Operation),
+		})
+		return
+	}
+
+	output, err := handler(ctx, request)
+	if err != nil {
+		writeError(w, request, err)
+		return
+	}
+
+	writeOutput(w, request, output)
+}
+
+func (s *Server) newRequest(r *http.Request) (*Request, error) {
+	service, region := credentialScope(r.Header.Get("Authorization"))
+	request := &Request{
+		Service: service,
+		Region:  region,
+		HTTP:    r,
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return request, err
+	}
+	request.Body = body
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+	switch target := r.Header.Get("X-Amz-Target"); {
+	case target != "":
+		request.Protocol = ProtocolAWSJSON10
+		if mediaType == "application/x-amz-json-1.1" {
+			request.Protocol = ProtocolAWSJSON11
+		}
+		_, request.Operation, _ = strings.Cut(target, ".")
+
+		request.Input = make(map[string]any)
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &request.Input); err != nil {
+				return request, NewError("SerializationException", "decoding request body: %s", err)
+			}
+		}
+
+	case mediaType == "application/x-www-form-urlencoded":
+		request.Protocol = ProtocolAWSQuery
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return request, NewError("MalformedQueryString", "decoding request body: %s", err)
+		}
+		request.Form = form
+		request.Operation = form.Get("Action")
+
+	default:
+		request.Protocol = ProtocolREST
+		s.mu.RLock()
+		resolver, ok := s.resolvers[service]
+		s.mu.RUnlock()
+		if ok {
+			request.Operation = resolver(r)
+		}
+	}
+
+	return request, nil
+}
+
+// credentialScope returns the signing name and region from an AWS Signature
+// Version 4 Authorization header value.
+func credentialScope(authorization string) (string, string) {
+	// AWS4-HMAC-SHA256 Credential=AKID/20260101/us-west-2/s3/aws4_request, SignedHeaders=..., Signature=...
+	_, credential, ok := strings.Cut(authorization, "Credential=")
+	if !ok {
+		return "", DefaultRegion
+	}
+
+	credential, _, _ = strings.Cut(credential, ",")
+	if parts := strings.Split(credential, "/"); len(parts) == 5 { //nolint:mnd // AKID/date/region/service/aws4_request
+		return parts[3], parts[2]
+	}
+
+	return "", DefaultRegion
+}
+
+func writeOutput(w http.ResponseWriter, request *Request, output any) {
+	if response, ok := output.(*Response); ok {
+		for k, v := range response.Header {
+			w.Header()[k] = v
+		}
+		statusCode := response.StatusCode
+		if statusCode == 0 {
+			statusCode = http.StatusOK
+		}
+		w.WriteHeader(statusCode)
+		w.Write(response.Body)
+		return
+	}
+
+	var (
+		body        []byte
+		contentType string
+		err         error
+	)
+	switch request.Protocol {
+	case ProtocolAWSJSON10, ProtocolAWSJSON11:
+		contentType = "application/x-amz-json-1.0"
+		if request.Protocol == ProtocolAWSJSON11 {
+			contentType = "application/x-amz-json-1.1"
+		}
+		if output == nil {
+			output = struct{}{}
+		}
+		body, err = json.Marshal(output)
+
+	case ProtocolAWSQuery:
+		contentType = "text/xml"
+		body, err = encodeQueryResponse(request.Operation, output)
+
+	case ProtocolREST:
+		contentType = "application/xml"
+		if output != nil {
+			body, err = encodeXML(output)
+		}
+	}
+
+	if err != nil {
+		writeError(w, request, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Amzn-Requestid", requestID)
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+func writeError(w http.ResponseWriter, request *Request, err error) {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		apiErr = &Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "InternalFailure",
+			Message:    err.Error(),
+		}
+	}
+
+	var (
+		body        []byte
+		contentType string
+	)
+	switch request.Protocol {
+	case ProtocolAWSJSON10, ProtocolAWSJSON11:
+		contentType = "application/x-amz-json-1.0"
+		if request.Protocol == ProtocolAWSJSON11 {
+			contentType = "application/x-amz-json-1.1"
+		}
+		w.Header().Set("X-Amzn-Errortype", apiErr.Code)
+		if apiErr.QueryErrorCode != "" {
+			w.Header().Set("X-Amzn-Query-Error", apiErr.QueryErrorCode+";Sender")
+		}
+		body, _ = json.Marshal(map[string]string{
+			"__type":  apiErr.Code,
+			"message": apiErr.Message,
+		})
+
+	case ProtocolAWSQuery:
+		contentType = "text/xml"
+		body = encodeQueryError(apiErr)
+
+	default:
+		contentType = "application/xml"
+		body = encodeRESTError(apiErr)
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Amzn-Requestid", requestID)
+	w.WriteHeader(apiErr.StatusCode)
+	if request.HTTP != nil && request.HTTP.Method == http.MethodHead {
+		return
+	}
+	w.Write(body)
+}
@@ -0,0 +1,518 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	secretsmanagertypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/internal/emulator"
+)
+
+func testServer(t *testing.T) (*emulator.Server, aws.Config) {
+	t.Helper()
+
+	server, err := emulator.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Close)
+
+	return server, aws.Config{
+		BaseEndpoint: aws.String(server.URL()),
+		Credentials:  credentials.NewStaticCredentialsProvider(emulator.AccessKeyID, emulator.SecretAccessKey, ""),
+		Region:       emulator.DefaultRegion,
+	}
+}
+
+func testErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("error = %v, want API error %s", err, want)
+	}
+	if got := apiErr.ErrorCode(); got != want {
+		t.Errorf("error code = %s, want %s", got, want)
+	}
+}
+
+func TestNew_unsupportedService(t *testing.T) {
+	t.Parallel()
+
+	if _, err := emulator.New("ec2"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestServer_notImplemented(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	_, cfg := testServer(t)
+	conn := sqs.NewFromConfig(cfg)
+
+	_, err := conn.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{QueueUrl: aws.String("queue")})
+	testErrorCode(t, err, "NotImplemented")
+}
+
+func TestServer_Register(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	server, cfg := testServer(t)
+	conn := sqs.NewFromConfig(cfg)
+
+	server.Register("sqs", "ReceiveMessage", func(ctx context.Context, request *emulator.Request) (any, error) {
+		return map[string]any{
+			"Messages": []any{map[string]any{"Body": request.Input["QueueUrl"]}},
+		}, nil
+	})
+
+	output, err := conn.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{QueueUrl: aws.String("queue")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aws.ToString(output.Messages[0].Body), "queue"; got != want {
+		t.Errorf("Body = %s, want %s", got, want)
+	}
+}
+
+func TestSTS(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	_, cfg := testServer(t)
+	conn := sts.NewFromConfig(cfg)
+
+	output, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aws.ToString(output.Account), emulator.AccountID; got != want {
+		t.Errorf("Account = %s, want %s", got, want)
+	}
+}
+
+func TestS3(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	_, cfg := testServer(t)
+	conn := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		o.UsePathStyle = true
+	})
+	const bucket = "tf-acc-test-bucket"
+
+	_, err := conn.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+		CreateBucketConfiguration: &s3types.CreateBucketConfiguration{
+			LocationConstraint: s3types.BucketLocationConstraintUsWest2,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	location, err := conn.GetBucketLocation(ctx, &s3.GetBucketLocationInput{Bucket: aws.String(bucket)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := location.LocationConstraint, s3types.BucketLocationConstraintUsWest2; got != want {
+		t.Errorf("LocationConstraint = %s, want %s", got, want)
+	}
+
+	_, err = conn.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{Bucket: aws.String(bucket)})
+	testErrorCode(t, err, "NoSuchBucketPolicy")
+
+	const policy = `{"Version":"2012-10-17","Statement":[]}`
+	if _, err := conn.PutBucketPolicy(ctx, &s3.PutBucketPolicyInput{Bucket: aws.String(bucket), Policy: aws.String(policy)}); err != nil {
+		t.Fatal(err)
+	}
+	policyOutput, err := conn.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{Bucket: aws.String(bucket)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aws.ToString(policyOutput.Policy), policy; got != want {
+		t.Errorf("Policy = %s, want %s", got, want)
+	}
+
+	if _, err := conn.PutBucketTagging(ctx, &s3.PutBucketTaggingInput{
+		Bucket:  aws.String(bucket),
+		Tagging: &s3types.Tagging{TagSet: []s3types.Tag{{Key: aws.String("k1"), Value: aws.String("v1")}}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	tagging, err := conn.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{Bucket: aws.String(bucket)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(tagging.TagSet), 1; got != want {
+		t.Errorf("len(TagSet) = %d, want %d", got, want)
+	}
+
+	versioning, err := conn.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{Bucket: aws.String(bucket)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := versioning.Status; got != "" {
+		t.Errorf("Status = %s, want empty", got)
+	}
+
+	if _, err := conn.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String("a/b.txt"),
+		Body:        strings.NewReader("hello"),
+		ContentType: aws.String("text/plain"),
+		Metadata:    map[string]string{"k": "v"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	object, err := conn.GetObject(ctx, &s3.GetObjectInput{Bucket: aws.String(bucket), Key: aws.String("a/b.txt")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := io.ReadAll(object.Body)
+	object.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(body), "hello"; got != want {
+		t.Errorf("Body = %s, want %s", got, want)
+	}
+	if got, want := aws.ToString(object.ContentType), "text/plain"; got != want {
+		t.Errorf("ContentType = %s, want %s", got, want)
+	}
+	if got, want := object.Metadata["k"], "v"; got != want {
+		t.Errorf("Metadata[k] = %s, want %s", got, want)
+	}
+
+	list, err := conn.ListObjectsV2(ctx, &s3.ListObjectsV2Input{Bucket: aws.String(bucket), Prefix: aws.String("a/")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(list.Contents), 1; got != want {
+		t.Errorf("len(Contents) = %d, want %d", got, want)
+	}
+
+	_, err = conn.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: aws.String(bucket)})
+	testErrorCode(t, err, "BucketNotEmpty")
+
+	if _, err := conn.DeleteObject(ctx, &s3.DeleteObjectInput{Bucket: aws.String(bucket), Key: aws.String("a/b.txt")}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conn.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: aws.String(bucket)}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(bucket)})
+	if !errors.As(err, new(*s3types.NotFound)) {
+		t.Errorf("HeadBucket error = %v, want NotFound", err)
+	}
+}
+
+func TestSQS(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	_, cfg := testServer(t)
+	conn := sqs.NewFromConfig(cfg)
+
+	create, err := conn.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName:  aws.String("tf-acc-test-queue"),
+		Attributes: map[string]string{"VisibilityTimeout": "60"},
+		Tags:       map[string]string{"k1": "v1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attributes, err := conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       create.QueueUrl,
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameAll},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := attributes.Attributes["VisibilityTimeout"], "60"; got != want {
+		t.Errorf("VisibilityTimeout = %s, want %s", got, want)
+	}
+	if got, want := attributes.Attributes["QueueArn"], "arn:aws:sqs:us-west-2:123456789012:tf-acc-test-queue"; got != want {
+		t.Errorf("QueueArn = %s, want %s", got, want)
+	}
+
+	tags, err := conn.ListQueueTags(ctx, &sqs.ListQueueTagsInput{QueueUrl: create.QueueUrl})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := tags.Tags["k1"], "v1"; got != want {
+		t.Errorf("Tags[k1] = %s, want %s", got, want)
+	}
+
+	if _, err := conn.DeleteQueue(ctx, &sqs.DeleteQueueInput{QueueUrl: create.QueueUrl}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{QueueUrl: create.QueueUrl})
+	testErrorCode(t, err, "AWS.SimpleQueueService.NonExistentQueue")
+}
+
+func TestSNS(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	_, cfg := testServer(t)
+	conn := sns.NewFromConfig(cfg)
+
+	create, err := conn.CreateTopic(ctx, &sns.CreateTopicInput{
+		Name:       aws.String("tf-acc-test-topic"),
+		Attributes: map[string]string{"DisplayName": "test"},
+		Tags:       []snstypes.Tag{{Key: aws.String("k1"), Value: aws.String("v1")}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attributes, err := conn.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{TopicArn: create.TopicArn})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := attributes.Attributes["DisplayName"], "test"; got != want {
+		t.Errorf("DisplayName = %s, want %s", got, want)
+	}
+
+	if _, err := conn.UntagResource(ctx, &sns.UntagResourceInput{ResourceArn: create.TopicArn, TagKeys: []string{"k1"}}); err != nil {
+		t.Fatal(err)
+	}
+	tags, err := conn.ListTagsForResource(ctx, &sns.ListTagsForResourceInput{ResourceArn: create.TopicArn})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(tags.Tags); got != 0 {
+		t.Errorf("len(Tags) = %d, want 0", got)
+	}
+
+	if _, err := conn.DeleteTopic(ctx, &sns.DeleteTopicInput{TopicArn: create.TopicArn}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{TopicArn: create.TopicArn})
+	if !errors.As(err, new(*snstypes.NotFoundException)) {
+		t.Errorf("GetTopicAttributes error = %v, want NotFoundException", err)
+	}
+}
+
+func TestDynamoDB(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	_, cfg := testServer(t)
+	conn := dynamodb.NewFromConfig(cfg)
+	const table = "tf-acc-test-table"
+
+	if _, err := conn.CreateTable(ctx, &dynamodb.CreateTableInput{
+		TableName:            aws.String(table),
+		AttributeDefinitions: []dynamodbtypes.AttributeDefinition{{AttributeName: aws.String("id"), AttributeType: dynamodbtypes.ScalarAttributeTypeS}},
+		KeySchema:            []dynamodbtypes.KeySchemaElement{{AttributeName: aws.String("id"), KeyType: dynamodbtypes.KeyTypeHash}},
+		BillingMode:          dynamodbtypes.BillingModePayPerRequest,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	describe, err := conn.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(table)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := describe.Table.TableStatus, dynamodbtypes.TableStatusActive; got != want {
+		t.Errorf("TableStatus = %s, want %s", got, want)
+	}
+	if got, want := describe.Table.BillingModeSummary.BillingMode, dynamodbtypes.BillingModePayPerRequest; got != want {
+		t.Errorf("BillingMode = %s, want %s", got, want)
+	}
+
+	key := map[string]dynamodbtypes.AttributeValue{"id": &dynamodbtypes.AttributeValueMemberS{Value: "1"}}
+	if _, err := conn.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(table),
+		Item: map[string]dynamodbtypes.AttributeValue{
+			"id":    &dynamodbtypes.AttributeValueMemberS{Value: "1"},
+			"value": &dynamodbtypes.AttributeValueMemberN{Value: "42"},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	item, err := conn.GetItem(ctx, &dynamodb.GetItemInput{TableName: aws.String(table), Key: key})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := item.Item["value"].(*dynamodbtypes.AttributeValueMemberN); !ok || v.Value != "42" {
+		t.Errorf("Item[value] = %v, want 42", item.Item["value"])
+	}
+
+	ttl, err := conn.DescribeTimeToLive(ctx, &dynamodb.DescribeTimeToLiveInput{TableName: aws.String(table)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ttl.TimeToLiveDescription.TimeToLiveStatus, dynamodbtypes.TimeToLiveStatusDisabled; got != want {
+		t.Errorf("TimeToLiveStatus = %s, want %s", got, want)
+	}
+
+	if _, err := conn.DeleteTable(ctx, &dynamodb.DeleteTableInput{TableName: aws.String(table)}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(table)})
+	if !errors.As(err, new(*dynamodbtypes.ResourceNotFoundException)) {
+		t.Errorf("DescribeTable error = %v, want ResourceNotFoundException", err)
+	}
+}
+
+func TestSSM(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	_, cfg := testServer(t)
+	conn := ssm.NewFromConfig(cfg)
+	const name = "/tf-acc-test/parameter"
+
+	if _, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:  aws.String(name),
+		Type:  ssmtypes.ParameterTypeSecureString,
+		Value: aws.String("v1"),
+		Tags:  []ssmtypes.Tag{{Key: aws.String("k1"), Value: aws.String("v1")}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := conn.PutParameter(ctx, &ssm.PutParameterInput{Name: aws.String(name), Value: aws.String("v2")})
+	if !errors.As(err, new(*ssmtypes.ParameterAlreadyExists)) {
+		t.Errorf("PutParameter error = %v, want ParameterAlreadyExists", err)
+	}
+
+	if _, err := conn.PutParameter(ctx, &ssm.PutParameterInput{Name: aws.String(name), Value: aws.String("v2"), Overwrite: aws.Bool(true)}); err != nil {
+		t.Fatal(err)
+	}
+
+	parameter, err := conn.GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String(name), WithDecryption: aws.Bool(true)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aws.ToString(parameter.Parameter.Value), "v2"; got != want {
+		t.Errorf("Value = %s, want %s", got, want)
+	}
+	if got, want := parameter.Parameter.Version, int64(2); got != want {
+		t.Errorf("Version = %d, want %d", got, want)
+	}
+
+	describe, err := conn.DescribeParameters(ctx, &ssm.DescribeParametersInput{
+		ParameterFilters: []ssmtypes.ParameterStringFilter{{Key: aws.String("Name"), Option: aws.String("Equals"), Values: []string{name}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(describe.Parameters), 1; got != want {
+		t.Fatalf("len(Parameters) = %d, want %d", got, want)
+	}
+	if got, want := aws.ToString(describe.Parameters[0].KeyId), "alias/aws/ssm"; got != want {
+		t.Errorf("KeyId = %s, want %s", got, want)
+	}
+
+	tags, err := conn.ListTagsForResource(ctx, &ssm.ListTagsForResourceInput{ResourceId: aws.String(name), ResourceType: ssmtypes.ResourceTypeForTaggingParameter})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(tags.TagList), 1; got != want {
+		t.Errorf("len(TagList) = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteParameter(ctx, &ssm.DeleteParameterInput{Name: aws.String(name)}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String(name)})
+	if !errors.As(err, new(*ssmtypes.ParameterNotFound)) {
+		t.Errorf("GetParameter error = %v, want ParameterNotFound", err)
+	}
+}
+
+func TestSecretsManager(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	_, cfg := testServer(t)
+	conn := secretsmanager.NewFromConfig(cfg)
+
+	create, err := conn.CreateSecret(ctx, &secretsmanager.CreateSecretInput{
+		Name:         aws.String("tf-acc-test-secret"),
+		SecretString: aws.String("v1"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := conn.PutSecretValue(ctx, &secretsmanager.PutSecretValueInput{SecretId: create.ARN, SecretString: aws.String("v2")}); err != nil {
+		t.Fatal(err)
+	}
+
+	value, err := conn.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{SecretId: aws.String("tf-acc-test-secret")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aws.ToString(value.SecretString), "v2"; got != want {
+		t.Errorf("SecretString = %s, want %s", got, want)
+	}
+
+	previous, err := conn.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{SecretId: create.ARN, VersionStage: aws.String("AWSPREVIOUS")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aws.ToString(previous.SecretString), "v1"; got != want {
+		t.Errorf("SecretString = %s, want %s", got, want)
+	}
+
+	if _, err := conn.DeleteSecret(ctx, &secretsmanager.DeleteSecretInput{SecretId: create.ARN, RecoveryWindowInDays: aws.Int64(7)}); err != nil {
+		t.Fatal(err)
+	}
+
+	describe, err := conn.DescribeSecret(ctx, &secretsmanager.DescribeSecretInput{SecretId: create.ARN})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if describe.DeletedDate == nil {
+		t.Error("DeletedDate = nil, want non-nil")
+	}
+
+	_, err = conn.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{SecretId: create.ARN})
+	if !errors.As(err, new(*secretsmanagertypes.InvalidRequestException)) {
+		t.Errorf("GetSecretValue error = %v, want InvalidRequestException", err)
+	}
+
+	if _, err := conn.DeleteSecret(ctx, &secretsmanager.DeleteSecretInput{SecretId: create.ARN, ForceDeleteWithoutRecovery: aws.Bool(true)}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.DescribeSecret(ctx, &secretsmanager.DescribeSecretInput{SecretId: create.ARN})
+	if !errors.As(err, new(*secretsmanagertypes.ResourceNotFoundException)) {
+		t.Errorf("DescribeSecret error = %v, want ResourceNotFoundException", err)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"os"
+	"strings"
+)
+
+const (
+	envVarEmulator = "TF_ACC_EMULATOR"
+
+	emulatorAllServices = "all"
+)
+
+// IsEnabled indicates whether acceptance tests should use the emulator
+//
+// Returns true if the TF_ACC_EMULATOR environment variable is set to a
+// non-empty value.
+func IsEnabled() bool {
+	return os.Getenv(envVarEmulator) != ""
+}
+
+// EnabledServices returns the services to emulate inferred from the TF_ACC_EMULATOR
+// environment variable, a comma-separated list of service names or "all"
+func EnabledServices() []string {
+	v := os.Getenv(envVarEmulator)
+	if v == "" {
+		return nil
+	}
+
+	if v == emulatorAllServices {
+		return Services()
+	}
+
+	var services []string
+	for service := range strings.SplitSeq(v, ",") {
+		if service = strings.TrimSpace(service); service != "" {
+			services = append(services, service)
+		}
+	}
+
+	return services
+}
@@ -0,0 +1,260 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const requestID = "00000000-0000-0000-0000-000000000000"
+
+func encodeXML(v any) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString(xml.Header)
+	if err := xml.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// encodeQueryResponse encodes an AWS Query protocol response.
+//
+//	<OperationResponse>
+//	  <OperationResult>...</OperationResult>
+//	  <ResponseMetadata><RequestId>...</RequestId></ResponseMetadata>
+//	</OperationResponse>
+func encodeQueryResponse(operation string, output any) ([]byte, error) {
+	var buf bytes.Buffer
+
+	encoder := xml.NewEncoder(&buf)
+	response := xml.StartElement{Name: xml.Name{Local: operation + "Response"}}
+	if err := encoder.EncodeToken(response); err != nil {
+		return nil, err
+	}
+	if output == nil {
+		output = struct{}{}
+	}
+	if err := encoder.EncodeElement(output, xml.StartElement{Name: xml.Name{Local: operation + "Result"}}); err != nil {
+		return nil, err
+	}
+	if err := encoder.EncodeElement(queryResponseMetadata{RequestID: requestID}, xml.StartElement{Name: xml.Name{Local: "ResponseMetadata"}}); err != nil {
+		return nil, err
+	}
+	if err := encoder.EncodeToken(response.End()); err != nil {
+		return nil, err
+	}
+	if err := encoder.Flush(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type queryResponseMetadata struct {
+	RequestID string `xml:"RequestId"`
+}
+
+func encodeQueryError(apiErr *Error) []byte {
+	type queryError struct {
+		Type    string `xml:"Type"`
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	type errorResponse struct {
+		XMLName   xml.Name   `xml:"ErrorResponse"`
+		Error     queryError `xml:"Error"`
+		RequestID string     `xml:"RequestId"`
+	}
+
+	errorType := "Sender"
+	if apiErr.StatusCode >= 500 { //nolint:mnd // HTTP 5xx
+		errorType = "Receiver"
+	}
+
+	body, _ := xml.Marshal(errorResponse{
+		Error: queryError{
+			Type:    errorType,
+			Code:    apiErr.Code,
+			Message: apiErr.Message,
+		},
+		RequestID: requestID,
+	})
+
+	return body
+}
+
+func encodeRESTError(apiErr *Error) []byte {
+	type restError struct {
+		XMLName   xml.Name `xml:"Error"`
+		Code      string   `xml:"Code"`
+		Message   string   `xml:"Message"`
+		RequestID string   `xml:"RequestId"`
+	}
+
+	body, _ := encodeXML(restError{
+		Code:      apiErr.Code,
+		Message:   apiErr.Message,
+		RequestID: requestID,
+	})
+
+	return body
+}
+
+// queryMap decodes an AWS Query protocol map parameter,
+// e.g. Attributes.entry.1.key=k&Attributes.entry.1.value=v.
+func queryMap(form url.Values, prefix, keyName, valueName string) map[string]string {
+	m := make(map[string]string)
+
+	for i := 1; ; i++ {
+		p := prefix + "." + strconv.Itoa(i) + "."
+		key, ok := form[p+keyName]
+		if !ok {
+			break
+		}
+		m[key[0]] = form.Get(p + valueName)
+	}
+
+	return m
+}
+
+// queryList decodes an AWS Query protocol list of strings parameter,
+// e.g. TagKeys.member.1=k1&TagKeys.member.2=k2.
+func queryList(form url.Values, prefix string) []string {
+	var l []string
+
+	for i := 1; ; i++ {
+		v, ok := form[prefix+"."+strconv.Itoa(i)]
+		if !ok {
+			break
+		}
+		l = append(l, v[0])
+	}
+
+	return l
+}
+
+// queryEntry is an AWS Query protocol map entry.
+type queryEntry struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+func queryEntries(m map[string]string) []queryEntry {
+	var entries []queryEntry
+
+	for _, k := range sortedKeys(m) {
+		entries = append(entries, queryEntry{Key: k, Value: m[k]})
+	}
+
+	return entries
+}
+
+// queryTag is an AWS Query protocol tag.
+type queryTag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+func queryTags(m map[string]string) []queryTag {
+	var tags []queryTag
+
+	for _, k := range sortedKeys(m) {
+		tags = append(tags, queryTag{Key: k, Value: m[k]})
+	}
+
+	return tags
+}
+
+// inputString returns the string value of an AWS JSON protocol input member.
+func inputString(input map[string]any, name string) string {
+	v, _ := input[name].(string)
+	return v
+}
+
+// inputBool returns the boolean value of an AWS JSON protocol input member.
+func inputBool(input map[string]any, name string) bool {
+	v, _ := input[name].(bool)
+	return v
+}
+
+// inputStrings returns the value of an AWS JSON protocol list of strings input member.
+func inputStrings(input map[string]any, name string) []string {
+	var l []string
+
+	v, _ := input[name].([]any)
+	for _, v := range v {
+		if v, ok := v.(string); ok {
+			l = append(l, v)
+		}
+	}
+
+	return l
+}
+
+// inputStringMap returns the value of an AWS JSON protocol map of strings input member.
+func inputStringMap(input map[string]any, name string) map[string]string {
+	m := make(map[string]string)
+
+	v, _ := input[name].(map[string]any)
+	for k, v := range v {
+		if v, ok := v.(string); ok {
+			m[k] = v
+		}
+	}
+
+	return m
+}
+
+// inputTags returns the value of an AWS JSON protocol list of tags input member.
+func inputTags(input map[string]any, name, keyName, valueName string) map[string]string {
+	m := make(map[string]string)
+
+	v, _ := input[name].([]any)
+	for _, v := range v {
+		if v, ok := v.(map[string]any); ok {
+			m[inputString(v, keyName)] = inputString(v, valueName)
+		}
+	}
+
+	return m
+}
+
+// outputTags returns the value of an AWS JSON protocol list of tags output member.
+func outputTags(m map[string]string, keyName, valueName string) []map[string]string {
+	tags := make([]map[string]string, 0, len(m))
+
+	for _, k := range sortedKeys(m) {
+		tags = append(tags, map[string]string{keyName: k, valueName: m[k]})
+	}
+
+	return tags
+}
+
+// epochSeconds returns the AWS JSON protocol representation of a timestamp.
+func epochSeconds(t time.Time) float64 {
+	return float64(t.UnixMilli()) / 1000 //nolint:mnd // milliseconds
+}
+
+func arn(service, region, resource string) string {
+	return fmt.Sprintf("arn:aws:%s:%s:%s:%s", service, region, AccountID, resource)
+}
+
+// deepCopy returns a deep copy of a decoded AWS JSON protocol document,
+// allowing handlers to return stored state that is encoded after their lock is released.
+func deepCopy(m map[string]any) map[string]any {
+	var v map[string]any
+
+	b, _ := json.Marshal(m)
+	json.Unmarshal(b, &v)
+
+	return v
+}
@@ -0,0 +1,30 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"maps"
+	"slices"
+)
+
+// builtinServices registers the built-in handlers for each emulated service,
+// keyed by AWS Signature Version 4 signing name.
+var builtinServices = map[string]func(*Server){
+	"dynamodb":       registerDynamoDB,
+	"s3":             registerS3,
+	"secretsmanager": registerSecretsManager,
+	"sns":            registerSNS,
+	"sqs":            registerSQS,
+	"ssm":            registerSSM,
+	"sts":            registerSTS,
+}
+
+// Services returns the names of the services with built-in handlers.
+func Services() []string {
+	return sortedKeys(builtinServices)
+}
+
+func sortedKeys[M ~map[string]V, V any](m M) []string {
+	return slices.Sorted(maps.Keys(m))
+}
@@ -0,0 +1,703 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // S3 ETags are MD5 digests
+	"encoding/hex"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const s3Namespace = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+type s3Object struct {
+	body         []byte
+	contentType  string
+	etag         string
+	lastModified time.Time
+	metadata     http.Header // x-amz-meta-* headers.
+	tagging      []byte
+}
+
+type s3Bucket struct {
+	name         string
+	region       string
+	creationDate time.Time
+	objects      map[string]*s3Object // Keyed by object key.
+	subresources map[string][]byte    // Stored configuration documents keyed by subresource.
+}
+
+type s3State struct {
+	mu      sync.Mutex
+	buckets map[string]*s3Bucket // Keyed by bucket name.
+}
+
+func (st *s3State) bucket(name string) (*s3Bucket, error) {
+	if bucket, ok := st.buckets[name]; ok {
+		return bucket, nil
+	}
+
+	return nil, &Error{
+		StatusCode: http.StatusNotFound,
+		Code:       "NoSuchBucket",
+		Message:    "The specified bucket does not exist",
+	}
+}
+
+func (st *s3State) object(bucketName, key string) (*s3Bucket, *s3Object, error) {
+	bucket, err := st.bucket(bucketName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if object, ok := bucket.objects[key]; ok {
+		return bucket, object, nil
+	}
+
+	return nil, nil, &Error{
+		StatusCode: http.StatusNotFound,
+		Code:       "NoSuchKey",
+		Message:    "The specified key does not exist.",
+	}
+}
+
+// s3BucketSubresource describes a bucket configuration subresource,
+// e.g. GET /bucket?policy (GetBucketPolicy).
+type s3BucketSubresource struct {
+	// operation is the operation name without its Get, Put or Delete verb.
+	operation string
+
+	// notFoundCode is the error code returned from Get operations if the configuration is not set.
+	notFoundCode string
+
+	// defaultDocument is returned from Get operations if the configuration is not set and there is no notFoundCode.
+	defaultDocument func(bucket *s3Bucket) string
+}
+
+var s3BucketSubresources = map[string]s3BucketSubresource{
+	"accelerate": {
+		operation: "BucketAccelerateConfiguration",
+		defaultDocument: func(*s3Bucket) string {
+			return `<AccelerateConfiguration xmlns="` + s3Namespace + `"/>`
+		},
+	},
+	"acl": {
+		operation: "BucketAcl",
+		defaultDocument: func(*s3Bucket) string {
+			return `<AccessControlPolicy xmlns="` + s3Namespace + `"><Owner><ID>` + AccountID + `</ID></Owner><AccessControlList><Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>` + AccountID + `</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant></AccessControlList></AccessControlPolicy>`
+		},
+	},
+	"cors": {
+		operation:    "BucketCors",
+		notFoundCode: "NoSuchCORSConfiguration",
+	},
+	"encryption": {
+		operation: "BucketEncryption",
+		defaultDocument: func(*s3Bucket) string {
+			return `<ServerSideEncryptionConfiguration xmlns="` + s3Namespace + `"><Rule><ApplyServerSideEncryptionByDefault><SSEAlgorithm>AES256</SSEAlgorithm></ApplyServerSideEncryptionByDefault><BucketKeyEnabled>false</BucketKeyEnabled></Rule></ServerSideEncryptionConfiguration>`
+		},
+	},
+	"lifecycle": {
+		operation:    "BucketLifecycleConfiguration",
+		notFoundCode: "NoSuchLifecycleConfiguration",
+	},
+	"location": {
+		operation: "BucketLocation",
+		defaultDocument: func(bucket *s3Bucket) string {
+			// Buckets in us-east-1 have a null location constraint.
+			if bucket.region == "us-east-1" {
+				return `<LocationConstraint xmlns="` + s3Namespace + `"/>`
+			}
+			return `<LocationConstraint xmlns="` + s3Namespace + `">` + bucket.region + `</LocationConstraint>`
+		},
+	},
+	"logging": {
+		operation: "BucketLogging",
+		defaultDocument: func(*s3Bucket) string {
+			return `<BucketLoggingStatus xmlns="` + s3Namespace + `"/>`
+		},
+	},
+	"notification": {
+		operation: "BucketNotificationConfiguration",
+		defaultDocument: func(*s3Bucket) string {
+			return `<NotificationConfiguration xmlns="` + s3Namespace + `"/>`
+		},
+	},
+	"object-lock": {
+		operation:    "ObjectLockConfiguration",
+		notFoundCode: "ObjectLockConfigurationNotFoundError",
+	},
+	"ownershipControls": {
+		operation: "BucketOwnershipControls",
+		defaultDocument: func(*s3Bucket) string {
+			return `<OwnershipControls xmlns="` + s3Namespace + `"><Rule><ObjectOwnership>BucketOwnerEnforced</ObjectOwnership></Rule></OwnershipControls>`
+		},
+	},
+	"policy": {
+		operation:    "BucketPolicy",
+		notFoundCode: "NoSuchBucketPolicy",
+	},
+	"publicAccessBlock": {
+		operation:    "PublicAccessBlock",
+		notFoundCode: "NoSuchPublicAccessBlockConfiguration",
+	},
+	"replication": {
+		operation:    "BucketReplication",
+		notFoundCode: "ReplicationConfigurationNotFoundError",
+	},
+	"requestPayment": {
+		operation: "BucketRequestPayment",
+		defaultDocument: func(*s3Bucket) string {
+			return `<RequestPaymentConfiguration xmlns="` + s3Namespace + `"><Payer>BucketOwner</Payer></RequestPaymentConfiguration>`
+		},
+	},
+	"tagging": {
+		operation:    "BucketTagging",
+		notFoundCode: "NoSuchTagSet",
+	},
+	"versioning": {
+		operation: "BucketVersioning",
+		defaultDocument: func(*s3Bucket) string {
+			return `<VersioningConfiguration xmlns="` + s3Namespace + `"/>`
+		},
+	},
+	"website": {
+		operation:    "BucketWebsite",
+		notFoundCode: "NoSuchWebsiteConfiguration",
+	},
+}
+
+// s3Path returns the bucket name and object key from a path-style request.
+func s3Path(r *http.Request) (string, string) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	return bucket, key
+}
+
+// s3ResolveOperation resolves the operation for a path-style S3 request.
+func s3ResolveOperation(r *http.Request) string {
+	bucket, key := s3Path(r)
+	query := r.URL.Query()
+
+	if bucket == "" {
+		if r.Method == http.MethodGet {
+			return "ListBuckets"
+		}
+		return ""
+	}
+
+	verb := map[string]string{
+		http.MethodDelete: "Delete",
+		http.MethodGet:    "Get",
+		http.MethodPut:    "Put",
+	}[r.Method]
+
+	if key != "" {
+		switch {
+		case query.Has("tagging") && verb != "":
+			return verb + "ObjectTagging"
+		case r.Method == http.MethodHead:
+			return "HeadObject"
+		case r.Method == http.MethodPut && r.Header.Get("X-Amz-Copy-Source") != "":
+			return "CopyObject"
+		case verb != "":
+			return verb + "Object"
+		}
+		return ""
+	}
+
+	for subresource, v := range s3BucketSubresources {
+		if query.Has(subresource) && verb != "" {
+			return verb + v.operation
+		}
+	}
+
+	switch r.Method {
+	case http.MethodDelete:
+		return "DeleteBucket"
+	case http.MethodGet:
+		switch {
+		case query.Has("uploads"):
+			return "ListMultipartUploads"
+		case query.Has("versions"):
+			return "ListObjectVersions"
+		case query.Get("list-type") == "2":
+			return "ListObjectsV2"
+		}
+		return "ListObjects"
+	case http.MethodHead:
+		return "HeadBucket"
+	case http.MethodPost:
+		if query.Has("delete") {
+			return "DeleteObjects"
+		}
+	case http.MethodPut:
+		return "CreateBucket"
+	}
+
+	return ""
+}
+
+// registerS3 registers handlers for the Amazon Simple Storage Service (S3) REST-XML protocol API.
+// Requests must use path-style addressing.
+func registerS3(s *Server) {
+	const service = "s3"
+	st := &s3State{
+		buckets: make(map[string]*s3Bucket),
+	}
+
+	s.RegisterResolver(service, s3ResolveOperation)
+
+	s.Register(service, "CreateBucket", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		name, _ := s3Path(request.HTTP)
+		if _, ok := st.buckets[name]; ok {
+			return nil, &Error{
+				StatusCode: http.StatusConflict,
+				Code:       "BucketAlreadyOwnedByYou",
+				Message:    "Your previous request to create the named bucket succeeded and you already own it.",
+			}
+		}
+
+		region := "us-east-1"
+		if len(request.Body) > 0 {
+			var configuration struct {
+				LocationConstraint string `xml:"LocationConstraint"`
+			}
+			if err := xml.Unmarshal(request.Body, &configuration); err != nil {
+				return nil, NewError("MalformedXML", "%s", err)
+			}
+			if configuration.LocationConstraint != "" {
+				region = configuration.LocationConstraint
+			}
+		}
+
+		st.buckets[name] = &s3Bucket{
+			name:         name,
+			region:       region,
+			creationDate: time.Now(),
+			objects:      make(map[string]*s3Object),
+			subresources: make(map[string][]byte),
+		}
+
+		return &Response{
+			Header: http.Header{"Location": []string{"/" + name}},
+		}, nil
+	})
+
+	s.Register(service, "HeadBucket", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		name, _ := s3Path(request.HTTP)
+		bucket, err := st.bucket(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Response{
+			Header: http.Header{"X-Amz-Bucket-Region": []string{bucket.region}},
+		}, nil
+	})
+
+	s.Register(service, "DeleteBucket", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		name, _ := s3Path(request.HTTP)
+		bucket, err := st.bucket(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(bucket.objects) > 0 {
+			return nil, &Error{
+				StatusCode: http.StatusConflict,
+				Code:       "BucketNotEmpty",
+				Message:    "The bucket you tried to delete is not empty",
+			}
+		}
+
+		delete(st.buckets, name)
+
+		return &Response{StatusCode: http.StatusNoContent}, nil
+	})
+
+	s.Register(service, "ListBuckets", func(ctx context.Context, request *Request) (any, error) {
+		type bucket struct {
+			Name         string `xml:"Name"`
+			BucketRegion string `xml:"BucketRegion"`
+			CreationDate string `xml:"CreationDate"`
+		}
+
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		var buckets []bucket
+		for _, name := range sortedKeys(st.buckets) {
+			buckets = append(buckets, bucket{
+				Name:         name,
+				BucketRegion: st.buckets[name].region,
+				CreationDate: st.buckets[name].creationDate.UTC().Format(time.RFC3339),
+			})
+		}
+
+		return struct {
+			XMLName xml.Name `xml:"ListAllMyBucketsResult"`
+			Xmlns   string   `xml:"xmlns,attr"`
+			OwnerID string   `xml:"Owner>ID"`
+			Buckets []bucket `xml:"Buckets>Bucket"`
+		}{
+			Xmlns:   s3Namespace,
+			OwnerID: AccountID,
+			Buckets: buckets,
+		}, nil
+	})
+
+	for subresource, v := range s3BucketSubresources {
+		s.Register(service, "Get"+v.operation, func(ctx context.Context, request *Request) (any, error) {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+
+			name, _ := s3Path(request.HTTP)
+			bucket, err := st.bucket(name)
+			if err != nil {
+				return nil, err
+			}
+
+			document, ok := bucket.subresources[subresource]
+			if !ok {
+				if v.notFoundCode != "" {
+					return nil, &Error{
+						StatusCode: http.StatusNotFound,
+						Code:       v.notFoundCode,
+						Message:    "The specified configuration does not exist",
+					}
+				}
+				document = []byte(v.defaultDocument(bucket))
+			}
+
+			// Bucket policies are JSON documents.
+			contentType := "application/xml"
+			if subresource == "policy" {
+				contentType = "application/json"
+			}
+
+			return &Response{
+				Header: http.Header{"Content-Type": []string{contentType}},
+				Body:   document,
+			}, nil
+		})
+
+		if subresource == "location" {
+			continue
+		}
+
+		s.Register(service, "Put"+v.operation, func(ctx context.Context, request *Request) (any, error) {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+
+			name, _ := s3Path(request.HTTP)
+			bucket, err := st.bucket(name)
+			if err != nil {
+				return nil, err
+			}
+
+			bucket.subresources[subresource] = request.Body
+
+			return &Response{}, nil
+		})
+
+		s.Register(service, "Delete"+v.operation, func(ctx context.Context, request *Request) (any, error) {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+
+			name, _ := s3Path(request.HTTP)
+			bucket, err := st.bucket(name)
+			if err != nil {
+				return nil, err
+			}
+
+			delete(bucket.subresources, subresource)
+
+			return &Response{StatusCode: http.StatusNoContent}, nil
+		})
+	}
+
+	s.Register(service, "PutObject", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		name, key := s3Path(request.HTTP)
+		bucket, err := st.bucket(name)
+		if err != nil {
+			return nil, err
+		}
+
+		object := s3PutObject(bucket, key, request.HTTP.Header, request.Body)
+
+		return &Response{
+			Header: http.Header{"Etag": []string{object.etag}},
+		}, nil
+	})
+
+	s.Register(service, "CopyObject", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		name, key := s3Path(request.HTTP)
+		bucket, err := st.bucket(name)
+		if err != nil {
+			return nil, err
+		}
+
+		sourceBucketName, sourceKey, _ := strings.Cut(strings.TrimPrefix(request.HTTP.Header.Get("X-Amz-Copy-Source"), "/"), "/")
+		_, source, err := st.object(sourceBucketName, sourceKey)
+		if err != nil {
+			return nil, err
+		}
+
+		header := request.HTTP.Header
+		if request.HTTP.Header.Get("X-Amz-Metadata-Directive") != "REPLACE" {
+			header = source.metadata.Clone()
+			header.Set("Content-Type", source.contentType)
+		}
+		object := s3PutObject(bucket, key, header, source.body)
+
+		return struct {
+			XMLName      xml.Name `xml:"CopyObjectResult"`
+			Xmlns        string   `xml:"xmlns,attr"`
+			ETag         string   `xml:"ETag"`
+			LastModified string   `xml:"LastModified"`
+		}{
+			Xmlns:        s3Namespace,
+			ETag:         object.etag,
+			LastModified: object.lastModified.UTC().Format(time.RFC3339),
+		}, nil
+	})
+
+	s.Register(service, "GetObject", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		_, object, err := st.object(s3Path(request.HTTP))
+		if err != nil {
+			return nil, err
+		}
+
+		return &Response{
+			Header: object.header(),
+			Body:   object.body,
+		}, nil
+	})
+
+	s.Register(service, "HeadObject", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		_, object, err := st.object(s3Path(request.HTTP))
+		if err != nil {
+			return nil, err
+		}
+
+		return &Response{
+			Header: object.header(),
+		}, nil
+	})
+
+	s.Register(service, "DeleteObject", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		name, key := s3Path(request.HTTP)
+		bucket, err := st.bucket(name)
+		if err != nil {
+			return nil, err
+		}
+
+		// DeleteObject is idempotent.
+		delete(bucket.objects, key)
+
+		return &Response{StatusCode: http.StatusNoContent}, nil
+	})
+
+	s.Register(service, "DeleteObjects", func(ctx context.Context, request *Request) (any, error) {
+		type deleted struct {
+			Key string `xml:"Key"`
+		}
+
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		name, _ := s3Path(request.HTTP)
+		bucket, err := st.bucket(name)
+		if err != nil {
+			return nil, err
+		}
+
+		var input struct {
+			Objects []deleted `xml:"Object"`
+		}
+		if err := xml.Unmarshal(request.Body, &input); err != nil {
+			return nil, NewError("MalformedXML", "%s", err)
+		}
+
+		for _, v := range input.Objects {
+			delete(bucket.objects, v.Key)
+		}
+
+		return struct {
+			XMLName xml.Name  `xml:"DeleteResult"`
+			Xmlns   string    `xml:"xmlns,attr"`
+			Deleted []deleted `xml:"Deleted"`
+		}{
+			Xmlns:   s3Namespace,
+			Deleted: input.Objects,
+		}, nil
+	})
+
+	s3ListObjects := func(ctx context.Context, request *Request) (any, error) {
+		type object struct {
+			Key          string `xml:"Key"`
+			ETag         string `xml:"ETag"`
+			LastModified string `xml:"LastModified"`
+			Size         int    `xml:"Size"`
+			StorageClass string `xml:"StorageClass"`
+		}
+
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		name, _ := s3Path(request.HTTP)
+		bucket, err := st.bucket(name)
+		if err != nil {
+			return nil, err
+		}
+
+		prefix := request.HTTP.URL.Query().Get("prefix")
+		var objects []object
+		for _, key := range sortedKeys(bucket.objects) {
+			if !strings.HasPrefix(key, prefix) {
+				continue
+			}
+			v := bucket.objects[key]
+			objects = append(objects, object{
+				Key:          key,
+				ETag:         v.etag,
+				LastModified: v.lastModified.UTC().Format(time.RFC3339),
+				Size:         len(v.body),
+				StorageClass: "STANDARD",
+			})
+		}
+
+		return struct {
+			XMLName     xml.Name `xml:"ListBucketResult"`
+			Xmlns       string   `xml:"xmlns,attr"`
+			Name        string   `xml:"Name"`
+			Prefix      string   `xml:"Prefix"`
+			KeyCount    int      `xml:"KeyCount"`
+			MaxKeys     int      `xml:"MaxKeys"`
+			IsTruncated bool     `xml:"IsTruncated"`
+			Contents    []object `xml:"Contents"`
+		}{
+			Xmlns:    s3Namespace,
+			Name:     name,
+			Prefix:   prefix,
+			KeyCount: len(objects),
+			MaxKeys:  1000, //nolint:mnd // Default page size
+			Contents: objects,
+		}, nil
+	}
+	s.Register(service, "ListObjects", s3ListObjects)
+	s.Register(service, "ListObjectsV2", s3ListObjects)
+
+	s.Register(service, "GetObjectTagging", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		_, object, err := st.object(s3Path(request.HTTP))
+		if err != nil {
+			return nil, err
+		}
+
+		document := object.tagging
+		if document == nil {
+			document = []byte(`<Tagging xmlns="` + s3Namespace + `"><TagSet/></Tagging>`)
+		}
+
+		return &Response{
+			Header: http.Header{"Content-Type": []string{"application/xml"}},
+			Body:   document,
+		}, nil
+	})
+
+	s.Register(service, "PutObjectTagging", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		_, object, err := st.object(s3Path(request.HTTP))
+		if err != nil {
+			return nil, err
+		}
+
+		object.tagging = request.Body
+
+		return &Response{}, nil
+	})
+
+	s.Register(service, "DeleteObjectTagging", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		_, object, err := st.object(s3Path(request.HTTP))
+		if err != nil {
+			return nil, err
+		}
+
+		object.tagging = nil
+
+		return &Response{StatusCode: http.StatusNoContent}, nil
+	})
+}
+
+func s3PutObject(bucket *s3Bucket, key string, header http.Header, body []byte) *s3Object {
+	digest := md5.Sum(body) //nolint:gosec // S3 ETags are MD5 digests
+
+	object := &s3Object{
+		body:         body,
+		contentType:  header.Get("Content-Type"),
+		etag:         `"` + hex.EncodeToString(digest[:]) + `"`,
+		lastModified: time.Now(),
+		metadata:     make(http.Header),
+	}
+	if object.contentType == "" {
+		object.contentType = "binary/octet-stream"
+	}
+	for k, v := range header {
+		if strings.HasPrefix(strings.ToLower(k), "x-amz-meta-") {
+			object.metadata[k] = v
+		}
+	}
+	bucket.objects[key] = object
+
+	return object
+}
+
+func (o *s3Object) header() http.Header {
+	header := o.metadata.Clone()
+
+	header.Set("Content-Length", strconv.Itoa(len(o.body)))
+	header.Set("Content-Type", o.contentType)
+	header.Set("Etag", o.etag)
+	header.Set("Last-Modified", o.lastModified.UTC().Format(http.TimeFormat))
+
+	return header
+}
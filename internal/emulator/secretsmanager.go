@@ -0,0 +1,463 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+type secretsManagerSecretVersion struct {
+	id           string
+	createdDate  time.Time
+	secretBinary string // Base64-encoded.
+	secretString string
+	stages       []string
+}
+
+type secretsManagerSecret struct {
+	name             string
+	arn              string
+	createdDate      time.Time
+	deletedDate      *time.Time
+	description      string
+	kmsKeyID         string
+	lastChangedDate  time.Time
+	resourcePolicy   string
+	tags             map[string]string
+	versions         map[string]*secretsManagerSecretVersion // Keyed by version ID.
+	currentVersionID string
+}
+
+func (s *secretsManagerSecret) versionIDsToStages() map[string][]string {
+	m := make(map[string][]string)
+
+	for id, version := range s.versions {
+		if len(version.stages) > 0 {
+			m[id] = slices.Clone(version.stages)
+		}
+	}
+
+	return m
+}
+
+// putVersion adds a new current version to the secret.
+func (s *secretsManagerSecret) putVersion(id string, input map[string]any) *secretsManagerSecretVersion {
+	if id == "" {
+		id = randomID()
+	}
+
+	for _, version := range s.versions {
+		version.stages = slices.DeleteFunc(version.stages, func(v string) bool {
+			return v == "AWSPREVIOUS"
+		})
+		if version.id == s.currentVersionID {
+			version.stages = []string{"AWSPREVIOUS"}
+		}
+	}
+
+	version := &secretsManagerSecretVersion{
+		id:           id,
+		createdDate:  time.Now(),
+		secretBinary: inputString(input, "SecretBinary"),
+		secretString: inputString(input, "SecretString"),
+		stages:       []string{"AWSCURRENT"},
+	}
+	s.versions[id] = version
+	s.currentVersionID = id
+	s.lastChangedDate = version.createdDate
+
+	return version
+}
+
+type secretsManagerState struct {
+	mu      sync.Mutex
+	secrets map[string]*secretsManagerSecret // Keyed by secret ARN.
+}
+
+func (st *secretsManagerState) secret(id string) (*secretsManagerSecret, error) {
+	for _, secret := range st.secrets {
+		if secret.arn == id || secret.name == id || strings.HasPrefix(secret.arn, id+"-") {
+			return secret, nil
+		}
+	}
+
+	return nil, NewError("ResourceNotFoundException", "Secrets Manager can't find the specified secret.")
+}
+
+func (st *secretsManagerState) activeSecret(id string) (*secretsManagerSecret, error) {
+	secret, err := st.secret(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if secret.deletedDate != nil {
+		return nil, NewError("InvalidRequestException", "You can't perform this operation on the secret because it was marked for deletion.")
+	}
+
+	return secret, nil
+}
+
+// registerSecretsManager registers handlers for the AWS Secrets Manager AWS JSON 1.1 protocol API.
+func registerSecretsManager(s *Server) {
+	const service = "secretsmanager"
+	st := &secretsManagerState{
+		secrets: make(map[string]*secretsManagerSecret),
+	}
+
+	s.Register(service, "CreateSecret", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		name := inputString(request.Input, "Name")
+		if secret, err := st.secret(name); err == nil {
+			if secret.deletedDate != nil {
+				return nil, NewError("InvalidRequestException", "You can't create this secret because a secret with this name is already scheduled for deletion.")
+			}
+			return nil, NewError("ResourceExistsException", "The operation failed because the secret %s already exists.", name)
+		}
+
+		now := time.Now()
+		secret := &secretsManagerSecret{
+			name:            name,
+			arn:             arn(service, request.Region, fmt.Sprintf("secret:%s-%s", name, randomID()[:6])),
+			createdDate:     now,
+			description:     inputString(request.Input, "Description"),
+			kmsKeyID:        inputString(request.Input, "KmsKeyId"),
+			lastChangedDate: now,
+			tags:            inputTags(request.Input, "Tags", "Key", "Value"),
+			versions:        make(map[string]*secretsManagerSecretVersion),
+		}
+		st.secrets[secret.arn] = secret
+
+		output := map[string]any{
+			"ARN":  secret.arn,
+			"Name": secret.name,
+		}
+		_, hasString := request.Input["SecretString"]
+		_, hasBinary := request.Input["SecretBinary"]
+		if hasString || hasBinary {
+			output["VersionId"] = secret.putVersion(inputString(request.Input, "ClientRequestToken"), request.Input).id
+		}
+
+		return output, nil
+	})
+
+	s.Register(service, "DescribeSecret", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		secret, err := st.secret(inputString(request.Input, "SecretId"))
+		if err != nil {
+			return nil, err
+		}
+
+		output := map[string]any{
+			"ARN":                secret.arn,
+			"CreatedDate":        epochSeconds(secret.createdDate),
+			"LastChangedDate":    epochSeconds(secret.lastChangedDate),
+			"Name":               secret.name,
+			"RotationEnabled":    false,
+			"Tags":               outputTags(secret.tags, "Key", "Value"),
+			"VersionIdsToStages": secret.versionIDsToStages(),
+		}
+		if secret.deletedDate != nil {
+			output["DeletedDate"] = epochSeconds(*secret.deletedDate)
+		}
+		if secret.description != "" {
+			output["Description"] = secret.description
+		}
+		if secret.kmsKeyID != "" {
+			output["KmsKeyId"] = secret.kmsKeyID
+		}
+
+		return output, nil
+	})
+
+	s.Register(service, "UpdateSecret", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		secret, err := st.activeSecret(inputString(request.Input, "SecretId"))
+		if err != nil {
+			return nil, err
+		}
+
+		if v, ok := request.Input["Description"]; ok {
+			secret.description, _ = v.(string)
+		}
+		if v, ok := request.Input["KmsKeyId"]; ok {
+			secret.kmsKeyID, _ = v.(string)
+		}
+		secret.lastChangedDate = time.Now()
+
+		output := map[string]any{
+			"ARN":  secret.arn,
+			"Name": secret.name,
+		}
+		_, hasString := request.Input["SecretString"]
+		_, hasBinary := request.Input["SecretBinary"]
+		if hasString || hasBinary {
+			output["VersionId"] = secret.putVersion(inputString(request.Input, "ClientRequestToken"), request.Input).id
+		}
+
+		return output, nil
+	})
+
+	s.Register(service, "PutSecretValue", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		secret, err := st.activeSecret(inputString(request.Input, "SecretId"))
+		if err != nil {
+			return nil, err
+		}
+
+		version := secret.putVersion(inputString(request.Input, "ClientRequestToken"), request.Input)
+
+		return map[string]any{
+			"ARN":           secret.arn,
+			"Name":          secret.name,
+			"VersionId":     version.id,
+			"VersionStages": slices.Clone(version.stages),
+		}, nil
+	})
+
+	s.Register(service, "GetSecretValue", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		secret, err := st.activeSecret(inputString(request.Input, "SecretId"))
+		if err != nil {
+			return nil, err
+		}
+
+		id, stage := inputString(request.Input, "VersionId"), inputString(request.Input, "VersionStage")
+		if id == "" && stage == "" {
+			stage = "AWSCURRENT"
+		}
+
+		var version *secretsManagerSecretVersion
+		for _, v := range secret.versions {
+			if (id == "" || v.id == id) && (stage == "" || slices.Contains(v.stages, stage)) {
+				version = v
+				break
+			}
+		}
+		if version == nil {
+			return nil, NewError("ResourceNotFoundException", "Secrets Manager can't find the specified secret value for staging label: %s", stage)
+		}
+
+		output := map[string]any{
+			"ARN":           secret.arn,
+			"CreatedDate":   epochSeconds(version.createdDate),
+			"Name":          secret.name,
+			"VersionId":     version.id,
+			"VersionStages": slices.Clone(version.stages),
+		}
+		if version.secretBinary != "" {
+			output["SecretBinary"] = version.secretBinary
+		} else {
+			output["SecretString"] = version.secretString
+		}
+
+		return output, nil
+	})
+
+	s.Register(service, "ListSecretVersionIds", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		secret, err := st.secret(inputString(request.Input, "SecretId"))
+		if err != nil {
+			return nil, err
+		}
+
+		versions := make([]any, 0)
+		for _, id := range sortedKeys(secret.versions) {
+			version := secret.versions[id]
+			if len(version.stages) == 0 && !inputBool(request.Input, "IncludeDeprecated") {
+				continue
+			}
+			versions = append(versions, map[string]any{
+				"CreatedDate":   epochSeconds(version.createdDate),
+				"VersionId":     version.id,
+				"VersionStages": slices.Clone(version.stages),
+			})
+		}
+
+		return map[string]any{
+			"ARN":      secret.arn,
+			"Name":     secret.name,
+			"Versions": versions,
+		}, nil
+	})
+
+	s.Register(service, "DeleteSecret", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		secret, err := st.secret(inputString(request.Input, "SecretId"))
+		if err != nil {
+			return nil, err
+		}
+
+		now := time.Now()
+		deletionDate := now
+		if inputBool(request.Input, "ForceDeleteWithoutRecovery") {
+			delete(st.secrets, secret.arn)
+		} else {
+			days := 30
+			if v, ok := request.Input["RecoveryWindowInDays"].(float64); ok {
+				days = int(v)
+			}
+			deletionDate = now.AddDate(0, 0, days)
+			secret.deletedDate = &now
+		}
+
+		return map[string]any{
+			"ARN":          secret.arn,
+			"DeletionDate": epochSeconds(deletionDate),
+			"Name":         secret.name,
+		}, nil
+	})
+
+	s.Register(service, "RestoreSecret", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		secret, err := st.secret(inputString(request.Input, "SecretId"))
+		if err != nil {
+			return nil, err
+		}
+
+		secret.deletedDate = nil
+
+		return map[string]any{
+			"ARN":  secret.arn,
+			"Name": secret.name,
+		}, nil
+	})
+
+	s.Register(service, "ListSecrets", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		secrets := make([]any, 0)
+		for _, arn := range sortedKeys(st.secrets) {
+			secret := st.secrets[arn]
+			if secret.deletedDate != nil && !inputBool(request.Input, "IncludePlannedDeletion") {
+				continue
+			}
+			secrets = append(secrets, map[string]any{
+				"ARN":             secret.arn,
+				"CreatedDate":     epochSeconds(secret.createdDate),
+				"Description":     secret.description,
+				"LastChangedDate": epochSeconds(secret.lastChangedDate),
+				"Name":            secret.name,
+				"Tags":            outputTags(secret.tags, "Key", "Value"),
+			})
+		}
+
+		return map[string]any{"SecretList": secrets}, nil
+	})
+
+	s.Register(service, "GetResourcePolicy", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		secret, err := st.secret(inputString(request.Input, "SecretId"))
+		if err != nil {
+			return nil, err
+		}
+
+		output := map[string]any{
+			"ARN":  secret.arn,
+			"Name": secret.name,
+		}
+		if secret.resourcePolicy != "" {
+			output["ResourcePolicy"] = secret.resourcePolicy
+		}
+
+		return output, nil
+	})
+
+	s.Register(service, "PutResourcePolicy", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		secret, err := st.activeSecret(inputString(request.Input, "SecretId"))
+		if err != nil {
+			return nil, err
+		}
+
+		secret.resourcePolicy = inputString(request.Input, "ResourcePolicy")
+
+		return map[string]any{
+			"ARN":  secret.arn,
+			"Name": secret.name,
+		}, nil
+	})
+
+	s.Register(service, "DeleteResourcePolicy", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		secret, err := st.secret(inputString(request.Input, "SecretId"))
+		if err != nil {
+			return nil, err
+		}
+
+		secret.resourcePolicy = ""
+
+		return map[string]any{
+			"ARN":  secret.arn,
+			"Name": secret.name,
+		}, nil
+	})
+
+	s.Register(service, "TagResource", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		secret, err := st.secret(inputString(request.Input, "SecretId"))
+		if err != nil {
+			return nil, err
+		}
+
+		maps.Copy(secret.tags, inputTags(request.Input, "Tags", "Key", "Value"))
+
+		return nil, nil
+	})
+
+	s.Register(service, "UntagResource", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		secret, err := st.secret(inputString(request.Input, "SecretId"))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, k := range inputStrings(request.Input, "TagKeys") {
+			delete(secret.tags, k)
+		}
+
+		return nil, nil
+	})
+}
+
+// randomID returns a random UUID-formatted identifier.
+func randomID() string {
+	b := make([]byte, 16) //nolint:mnd // 128 bits
+	rand.Read(b)
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
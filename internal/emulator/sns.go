@@ -0,0 +1,198 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+type snsTopic struct {
+	arn        string
+	attributes map[string]string
+	tags       map[string]string
+}
+
+type snsState struct {
+	mu     sync.Mutex
+	topics map[string]*snsTopic // Keyed by topic ARN.
+}
+
+func (st *snsState) topic(arn string) (*snsTopic, error) {
+	if topic, ok := st.topics[arn]; ok {
+		return topic, nil
+	}
+
+	return nil, &Error{
+		StatusCode: http.StatusNotFound,
+		Code:       "NotFound",
+		Message:    "Topic does not exist",
+	}
+}
+
+// registerSNS registers handlers for the Amazon Simple Notification Service (SNS) AWS Query protocol API.
+func registerSNS(s *Server) {
+	const service = "sns"
+	st := &snsState{
+		topics: make(map[string]*snsTopic),
+	}
+
+	s.Register(service, "CreateTopic", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		name := request.Form.Get("Name")
+		arn := arn(service, request.Region, name)
+
+		if _, ok := st.topics[arn]; !ok {
+			topic := &snsTopic{
+				arn: arn,
+				attributes: map[string]string{
+					"DisplayName":             "",
+					"EffectiveDeliveryPolicy": `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false,"defaultRequestPolicy":{"headerContentType":"text/plain; charset=UTF-8"}}}`,
+					"Owner":                   AccountID,
+					"Policy":                  snsDefaultTopicPolicy(arn),
+					"SubscriptionsConfirmed":  "0",
+					"SubscriptionsDeleted":    "0",
+					"SubscriptionsPending":    "0",
+					"TopicArn":                arn,
+				},
+				tags: queryMap(request.Form, "Tags.member", "Key", "Value"),
+			}
+			if strings.HasSuffix(name, ".fifo") {
+				topic.attributes["ContentBasedDeduplication"] = "false"
+			}
+			maps.Copy(topic.attributes, queryMap(request.Form, "Attributes.entry", "key", "value"))
+			st.topics[arn] = topic
+		}
+
+		return struct {
+			TopicArn string `xml:"TopicArn"`
+		}{
+			TopicArn: arn,
+		}, nil
+	})
+
+	s.Register(service, "GetTopicAttributes", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		topic, err := st.topic(request.Form.Get("TopicArn"))
+		if err != nil {
+			return nil, err
+		}
+
+		return struct {
+			Attributes []queryEntry `xml:"Attributes>entry"`
+		}{
+			Attributes: queryEntries(topic.attributes),
+		}, nil
+	})
+
+	s.Register(service, "SetTopicAttributes", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		topic, err := st.topic(request.Form.Get("TopicArn"))
+		if err != nil {
+			return nil, err
+		}
+
+		topic.attributes[request.Form.Get("AttributeName")] = request.Form.Get("AttributeValue")
+
+		return nil, nil
+	})
+
+	s.Register(service, "DeleteTopic", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		// DeleteTopic is idempotent.
+		delete(st.topics, request.Form.Get("TopicArn"))
+
+		return nil, nil
+	})
+
+	s.Register(service, "ListTopics", func(ctx context.Context, request *Request) (any, error) {
+		type topic struct {
+			TopicArn string `xml:"TopicArn"`
+		}
+
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		var topics []topic
+		for _, arn := range sortedKeys(st.topics) {
+			topics = append(topics, topic{TopicArn: arn})
+		}
+
+		return struct {
+			Topics []topic `xml:"Topics>member"`
+		}{
+			Topics: topics,
+		}, nil
+	})
+
+	s.Register(service, "ListTagsForResource", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		topic, err := st.topic(request.Form.Get("ResourceArn"))
+		if err != nil {
+			return nil, snsResourceNotFoundError(err)
+		}
+
+		return struct {
+			Tags []queryTag `xml:"Tags>member"`
+		}{
+			Tags: queryTags(topic.tags),
+		}, nil
+	})
+
+	s.Register(service, "TagResource", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		topic, err := st.topic(request.Form.Get("ResourceArn"))
+		if err != nil {
+			return nil, snsResourceNotFoundError(err)
+		}
+
+		maps.Copy(topic.tags, queryMap(request.Form, "Tags.member", "Key", "Value"))
+
+		return nil, nil
+	})
+
+	s.Register(service, "UntagResource", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		topic, err := st.topic(request.Form.Get("ResourceArn"))
+		if err != nil {
+			return nil, snsResourceNotFoundError(err)
+		}
+
+		for _, k := range queryList(request.Form, "TagKeys.member") {
+			delete(topic.tags, k)
+		}
+
+		return nil, nil
+	})
+}
+
+func snsResourceNotFoundError(err error) error {
+	return &Error{
+		StatusCode: http.StatusNotFound,
+		Code:       "ResourceNotFound",
+		Message:    err.(*Error).Message,
+	}
+}
+
+func snsDefaultTopicPolicy(arn string) string {
+	return fmt.Sprintf(`{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:SetTopicAttributes","SNS:AddPermission","SNS:RemovePermission","SNS:DeleteTopic","SNS:Subscribe","SNS:ListSubscriptionsByTopic","SNS:Publish"],"Resource":"%[1]s","Condition":{"StringEquals":{"AWS:SourceOwner":"%[2]s"}}}]}`, arn, AccountID)
+}
@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type sqsQueue struct {
+	name       string
+	url        string
+	attributes map[string]string
+	tags       map[string]string
+}
+
+type sqsState struct {
+	mu     sync.Mutex
+	queues map[string]*sqsQueue // Keyed by queue name.
+}
+
+func (st *sqsState) queue(nameOrURL string) (*sqsQueue, error) {
+	name := nameOrURL[strings.LastIndex(nameOrURL, "/")+1:]
+	if queue, ok := st.queues[name]; ok {
+		return queue, nil
+	}
+
+	return nil, &Error{
+		StatusCode:     http.StatusBadRequest,
+		Code:           "QueueDoesNotExist",
+		Message:        "The specified queue does not exist.",
+		QueryErrorCode: "AWS.SimpleQueueService.NonExistentQueue",
+	}
+}
+
+// registerSQS registers handlers for the Amazon Simple Queue Service (SQS) AWS JSON 1.0 protocol API.
+func registerSQS(s *Server) {
+	const service = "sqs"
+	st := &sqsState{
+		queues: make(map[string]*sqsQueue),
+	}
+
+	s.Register(service, "CreateQueue", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		name := inputString(request.Input, "QueueName")
+		attributes := inputStringMap(request.Input, "Attributes")
+
+		if queue, ok := st.queues[name]; ok {
+			for k, v := range attributes {
+				if queue.attributes[k] != v {
+					return nil, &Error{
+						StatusCode:     http.StatusBadRequest,
+						Code:           "QueueNameExists",
+						Message:        "A queue already exists with the same name and a different value for attribute " + k,
+						QueryErrorCode: "QueueAlreadyExists",
+					}
+				}
+			}
+			return map[string]any{"QueueUrl": queue.url}, nil
+		}
+
+		now := strconv.FormatInt(time.Now().Unix(), 10)
+		queue := &sqsQueue{
+			name: name,
+			url:  "http://" + request.HTTP.Host + "/" + AccountID + "/" + name,
+			attributes: map[string]string{
+				"ApproximateNumberOfMessages":           "0",
+				"ApproximateNumberOfMessagesDelayed":    "0",
+				"ApproximateNumberOfMessagesNotVisible": "0",
+				"CreatedTimestamp":                      now,
+				"DelaySeconds":                          "0",
+				"LastModifiedTimestamp":                 now,
+				"MaximumMessageSize":                    "262144",
+				"MessageRetentionPeriod":                "345600",
+				"QueueArn":                              arn(service, request.Region, name),
+				"ReceiveMessageWaitTimeSeconds":         "0",
+				"SqsManagedSseEnabled":                  "true",
+				"VisibilityTimeout":                     "30",
+			},
+			tags: inputStringMap(request.Input, "tags"),
+		}
+		if strings.HasSuffix(name, ".fifo") {
+			queue.attributes["ContentBasedDeduplication"] = "false"
+			queue.attributes["DeduplicationScope"] = "queue"
+			queue.attributes["FifoQueue"] = "true"
+			queue.attributes["FifoThroughputLimit"] = "perQueue"
+		}
+		if _, ok := attributes["KmsMasterKeyId"]; ok {
+			queue.attributes["KmsDataKeyReusePeriodSeconds"] = "300"
+			delete(queue.attributes, "SqsManagedSseEnabled")
+		}
+		maps.Copy(queue.attributes, attributes)
+		st.queues[name] = queue
+
+		return map[string]any{"QueueUrl": queue.url}, nil
+	})
+
+	s.Register(service, "GetQueueUrl", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		queue, err := st.queue(inputString(request.Input, "QueueName"))
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]any{"QueueUrl": queue.url}, nil
+	})
+
+	s.Register(service, "GetQueueAttributes", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		queue, err := st.queue(inputString(request.Input, "QueueUrl"))
+		if err != nil {
+			return nil, err
+		}
+
+		names := inputStrings(request.Input, "AttributeNames")
+		attributes := make(map[string]string)
+		for k, v := range queue.attributes {
+			if slices.Contains(names, "All") || slices.Contains(names, k) {
+				attributes[k] = v
+			}
+		}
+
+		return map[string]any{"Attributes": attributes}, nil
+	})
+
+	s.Register(service, "SetQueueAttributes", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		queue, err := st.queue(inputString(request.Input, "QueueUrl"))
+		if err != nil {
+			return nil, err
+		}
+
+		maps.Copy(queue.attributes, inputStringMap(request.Input, "Attributes"))
+		queue.attributes["LastModifiedTimestamp"] = strconv.FormatInt(time.Now().Unix(), 10)
+
+		return nil, nil
+	})
+
+	s.Register(service, "DeleteQueue", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		queue, err := st.queue(inputString(request.Input, "QueueUrl"))
+		if err != nil {
+			return nil, err
+		}
+
+		delete(st.queues, queue.name)
+
+		return nil, nil
+	})
+
+	s.Register(service, "ListQueues", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		prefix := inputString(request.Input, "QueueNamePrefix")
+		urls := make([]string, 0)
+		for _, name := range sortedKeys(st.queues) {
+			if strings.HasPrefix(name, prefix) {
+				urls = append(urls, st.queues[name].url)
+			}
+		}
+
+		return map[string]any{"QueueUrls": urls}, nil
+	})
+
+	s.Register(service, "ListQueueTags", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		queue, err := st.queue(inputString(request.Input, "QueueUrl"))
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]any{"Tags": maps.Clone(queue.tags)}, nil
+	})
+
+	s.Register(service, "TagQueue", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		queue, err := st.queue(inputString(request.Input, "QueueUrl"))
+		if err != nil {
+			return nil, err
+		}
+
+		maps.Copy(queue.tags, inputStringMap(request.Input, "Tags"))
+
+		return nil, nil
+	})
+
+	s.Register(service, "UntagQueue", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		queue, err := st.queue(inputString(request.Input, "QueueUrl"))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, k := range inputStrings(request.Input, "TagKeys") {
+			delete(queue.tags, k)
+		}
+
+		return nil, nil
+	})
+}
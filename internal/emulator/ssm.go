@@ -0,0 +1,292 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"context"
+	"maps"
+	"strings"
+	"sync"
+	"time"
+)
+
+type ssmParameter struct {
+	name             string
+	arn              string
+	allowedPattern   string
+	dataType         string
+	description      string
+	keyID            string
+	lastModifiedDate time.Time
+	parameterType    string
+	tier             string
+	value            string
+	version          int
+	tags             map[string]string
+}
+
+func (p *ssmParameter) output() map[string]any {
+	return map[string]any{
+		"ARN":              p.arn,
+		"DataType":         p.dataType,
+		"LastModifiedDate": epochSeconds(p.lastModifiedDate),
+		"Name":             p.name,
+		"Type":             p.parameterType,
+		"Value":            p.value,
+		"Version":          p.version,
+	}
+}
+
+func (p *ssmParameter) metadata() map[string]any {
+	m := map[string]any{
+		"ARN":              p.arn,
+		"DataType":         p.dataType,
+		"LastModifiedDate": epochSeconds(p.lastModifiedDate),
+		"LastModifiedUser": "arn:aws:iam::" + AccountID + ":user/emulator",
+		"Name":             p.name,
+		"Tier":             p.tier,
+		"Type":             p.parameterType,
+		"Version":          p.version,
+		"Policies":         []any{},
+	}
+	if p.allowedPattern != "" {
+		m["AllowedPattern"] = p.allowedPattern
+	}
+	if p.description != "" {
+		m["Description"] = p.description
+	}
+	if p.keyID != "" {
+		m["KeyId"] = p.keyID
+	}
+
+	return m
+}
+
+type ssmState struct {
+	mu         sync.Mutex
+	parameters map[string]*ssmParameter // Keyed by parameter name.
+}
+
+func (st *ssmState) parameter(name string) (*ssmParameter, error) {
+	// Parameters can be referenced by ARN.
+	if _, v, ok := strings.Cut(name, ":parameter"); ok && strings.HasPrefix(name, "arn:") {
+		name = v
+		if _, ok := st.parameters[name]; !ok {
+			name = strings.TrimPrefix(name, "/")
+		}
+	}
+
+	if parameter, ok := st.parameters[name]; ok {
+		return parameter, nil
+	}
+
+	return nil, NewError("ParameterNotFound", "Parameter %s not found.", name)
+}
+
+// registerSSM registers handlers for the AWS Systems Manager (SSM) AWS JSON 1.1 protocol API.
+func registerSSM(s *Server) {
+	const service = "ssm"
+	st := &ssmState{
+		parameters: make(map[string]*ssmParameter),
+	}
+
+	s.Register(service, "PutParameter", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		name := inputString(request.Input, "Name")
+		parameter, ok := st.parameters[name]
+
+		switch {
+		case ok && !inputBool(request.Input, "Overwrite"):
+			return nil, NewError("ParameterAlreadyExists", "The parameter already exists. To overwrite this value, set the overwrite option in the request to true.")
+		case ok:
+			if len(inputTags(request.Input, "Tags", "Key", "Value")) > 0 {
+				return nil, NewError("ValidationException", "Invalid request: tags and overwrite can't be used together.")
+			}
+		default:
+			parameter = &ssmParameter{
+				name:          name,
+				arn:           arn(service, request.Region, "parameter/"+strings.TrimPrefix(name, "/")),
+				dataType:      "text",
+				parameterType: "String",
+				tier:          "Standard",
+				tags:          inputTags(request.Input, "Tags", "Key", "Value"),
+			}
+			st.parameters[name] = parameter
+		}
+
+		if v, ok := request.Input["AllowedPattern"]; ok {
+			parameter.allowedPattern, _ = v.(string)
+		}
+		if v := inputString(request.Input, "DataType"); v != "" {
+			parameter.dataType = v
+		}
+		if v, ok := request.Input["Description"]; ok {
+			parameter.description, _ = v.(string)
+		}
+		if v := inputString(request.Input, "Tier"); v != "" && v != "Intelligent-Tiering" {
+			parameter.tier = v
+		}
+		if v := inputString(request.Input, "Type"); v != "" {
+			parameter.parameterType = v
+		}
+		if parameter.parameterType == "SecureString" {
+			parameter.keyID = "alias/aws/ssm"
+			if v := inputString(request.Input, "KeyId"); v != "" {
+				parameter.keyID = v
+			}
+		}
+		parameter.lastModifiedDate = time.Now()
+		parameter.value = inputString(request.Input, "Value")
+		parameter.version++
+
+		return map[string]any{
+			"Tier":    parameter.tier,
+			"Version": parameter.version,
+		}, nil
+	})
+
+	s.Register(service, "GetParameter", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		parameter, err := st.parameter(inputString(request.Input, "Name"))
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]any{"Parameter": parameter.output()}, nil
+	})
+
+	s.Register(service, "GetParameters", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		parameters, invalidParameters := make([]any, 0), make([]string, 0)
+		for _, name := range inputStrings(request.Input, "Names") {
+			if parameter, err := st.parameter(name); err == nil {
+				parameters = append(parameters, parameter.output())
+			} else {
+				invalidParameters = append(invalidParameters, name)
+			}
+		}
+
+		return map[string]any{
+			"InvalidParameters": invalidParameters,
+			"Parameters":        parameters,
+		}, nil
+	})
+
+	s.Register(service, "DescribeParameters", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		var names []string
+		filters, _ := request.Input["ParameterFilters"].([]any)
+		for _, v := range filters {
+			if v, ok := v.(map[string]any); ok && inputString(v, "Key") == "Name" {
+				names = append(names, inputStrings(v, "Values")...)
+			}
+		}
+
+		parameters := make([]any, 0)
+		for _, name := range sortedKeys(st.parameters) {
+			if len(names) > 0 {
+				var match bool
+				for _, v := range names {
+					if strings.TrimPrefix(v, "/") == strings.TrimPrefix(name, "/") {
+						match = true
+					}
+				}
+				if !match {
+					continue
+				}
+			}
+			parameters = append(parameters, st.parameters[name].metadata())
+		}
+
+		return map[string]any{"Parameters": parameters}, nil
+	})
+
+	s.Register(service, "DeleteParameter", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		parameter, err := st.parameter(inputString(request.Input, "Name"))
+		if err != nil {
+			return nil, err
+		}
+
+		delete(st.parameters, parameter.name)
+
+		return nil, nil
+	})
+
+	s.Register(service, "DeleteParameters", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		deletedParameters, invalidParameters := make([]string, 0), make([]string, 0)
+		for _, name := range inputStrings(request.Input, "Names") {
+			if parameter, err := st.parameter(name); err == nil {
+				delete(st.parameters, parameter.name)
+				deletedParameters = append(deletedParameters, name)
+			} else {
+				invalidParameters = append(invalidParameters, name)
+			}
+		}
+
+		return map[string]any{
+			"DeletedParameters": deletedParameters,
+			"InvalidParameters": invalidParameters,
+		}, nil
+	})
+
+	s.Register(service, "ListTagsForResource", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		parameter, err := st.parameter(inputString(request.Input, "ResourceId"))
+		if err != nil {
+			return nil, ssmInvalidResourceIDError(err)
+		}
+
+		return map[string]any{"TagList": outputTags(parameter.tags, "Key", "Value")}, nil
+	})
+
+	s.Register(service, "AddTagsToResource", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		parameter, err := st.parameter(inputString(request.Input, "ResourceId"))
+		if err != nil {
+			return nil, ssmInvalidResourceIDError(err)
+		}
+
+		maps.Copy(parameter.tags, inputTags(request.Input, "Tags", "Key", "Value"))
+
+		return nil, nil
+	})
+
+	s.Register(service, "RemoveTagsFromResource", func(ctx context.Context, request *Request) (any, error) {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		parameter, err := st.parameter(inputString(request.Input, "ResourceId"))
+		if err != nil {
+			return nil, ssmInvalidResourceIDError(err)
+		}
+
+		for _, k := range inputStrings(request.Input, "TagKeys") {
+			delete(parameter.tags, k)
+		}
+
+		return nil, nil
+	})
+}
+
+func ssmInvalidResourceIDError(err error) error {
+	return NewError("InvalidResourceId", "%s", err.(*Error).Message)
+}
@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package emulator
+
+import (
+	"context"
+)
+
+// registerSTS registers handlers for the AWS Security Token Service (STS) AWS Query protocol API.
+func registerSTS(s *Server) {
+	const service = "sts"
+
+	s.Register(service, "GetCallerIdentity", func(ctx context.Context, request *Request) (any, error) {
+		return struct {
+			Account string `xml:"Account"`
+			Arn     string `xml:"Arn"`
+			UserID  string `xml:"UserId"`
+		}{
+			Account: AccountID,
+			Arn:     "arn:aws:iam::" + AccountID + ":user/emulator",
+			UserID:  AccessKeyID,
+		}, nil
+	})
+}
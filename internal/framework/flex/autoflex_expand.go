@@ -69,6 +69,8 @@ type autoExpander struct {
 func newAutoExpander(optFns []AutoFlexOptionsFunc) *autoExpander {
 	o := AutoFlexOptions{
 		ignoredFieldNames: DefaultIgnoredFieldNames,
+		// Generated conversion code implements the default options only.
+		useGeneratedCode: len(optFns) == 0,
 	}
 
 	for _, optFn := range optFns {
@@ -1387,6 +1389,15 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 		return diags
 	}
 
+	if fromGeneratedExpander, ok := valFrom.Interface().(GeneratedExpander); ok && flexer.getOptions().useGeneratedCode && valTo.CanAddr() {
+		ok, d := fromGeneratedExpander.AutoFlexExpandTo(ctx, valTo.Addr().Interface())
+		diags.Append(d...)
+		if ok {
+			tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.GeneratedExpander")
+			return diags
+		}
+	}
+
 	if valTo.Kind() == reflect.Interface {
 		tflog.SubsystemError(ctx, subsystemName, "Expanding to incompatible interface")
 		// TODO: Should continue failing silently for now
@@ -62,6 +62,8 @@ type autoFlattener struct {
 func newAutoFlattener(optFns []AutoFlexOptionsFunc) *autoFlattener {
 	o := AutoFlexOptions{
 		ignoredFieldNames: DefaultIgnoredFieldNames,
+		// Generated conversion code implements the default options only.
+		useGeneratedCode: len(optFns) == 0,
 	}
 
 	for _, optFn := range optFns {
@@ -2185,6 +2187,15 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 		return diags
 	}
 
+	if toGeneratedFlattener, ok := to.(GeneratedFlattener); ok && flexer.getOptions().useGeneratedCode {
+		ok, d := toGeneratedFlattener.AutoFlexFlattenFrom(ctx, valFrom.Interface())
+		diags.Append(d...)
+		if ok {
+			tflog.SubsystemInfo(ctx, subsystemName, "Target implements flex.GeneratedFlattener")
+			return diags
+		}
+	}
+
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
 
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Support for conversion code generated by internal/generate/autoflex.
+//
+// Generated code converts between a model and an AWS API struct without walking either
+// type with reflection or matching field names at runtime.
+// It is only used when Expand or Flatten is called without options.
+
+// GeneratedExpander is implemented by types with generated expansion code.
+type GeneratedExpander interface {
+	// AutoFlexExpandTo expands into target, a pointer to an AWS API struct.
+	// Returns false if there is no generated code for the target type.
+	AutoFlexExpandTo(ctx context.Context, target any) (bool, diag.Diagnostics)
+}
+
+// GeneratedFlattener is implemented by types with generated flattening code.
+type GeneratedFlattener interface {
+	// AutoFlexFlattenFrom flattens source, an AWS API struct value.
+	// Returns false if there is no generated code for the source type.
+	AutoFlexFlattenFrom(ctx context.Context, source any) (bool, diag.Diagnostics)
+}
+
+// ExpandField expands a single struct field value into the value pointed to by `to`.
+// It is called by generated code for fields that are not converted directly,
+// and behaves as if the field had been expanded as part of its containing struct.
+func ExpandField(ctx context.Context, from, to any, legacy, omitempty bool) diag.Diagnostics {
+	expander := newAutoExpander(nil)
+	opts := fieldOpts{
+		legacy:    legacy,
+		omitempty: omitempty,
+	}
+
+	return expander.convert(ctx, path.Empty(), reflect.ValueOf(from), path.Empty(), reflect.ValueOf(to).Elem(), opts)
+}
+
+// FlattenField flattens the struct field value pointed to by `from` into the value pointed to by `to`.
+// It is called by generated code for fields that are not converted directly,
+// and behaves as if the field had been flattened as part of its containing struct.
+func FlattenField(ctx context.Context, from, to any, legacy, omitempty bool) diag.Diagnostics {
+	flattener := newAutoFlattener(nil)
+	opts := fieldOpts{
+		legacy:    legacy,
+		omitempty: omitempty,
+	}
+
+	return flattener.convert(ctx, path.Empty(), reflect.ValueOf(from).Elem(), path.Empty(), reflect.ValueOf(to).Elem(), opts)
+}
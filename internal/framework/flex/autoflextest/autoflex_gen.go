@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "internal/generate/autoflex/main.go -Expand=resourceModel:resourceStruct,settingModel:settingStruct -Flatten=resourceModel:resourceStruct,settingModel:settingStruct"; DO NOT EDIT.
+
+package autoflextest
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+var _ fwflex.GeneratedExpander = resourceModel{}
+
+func (m resourceModel) AutoFlexExpandTo(ctx context.Context, target any) (bool, diag.Diagnostics) {
+	switch target := target.(type) {
+	case *resourceStruct:
+		return true, m.autoFlexExpandToResourceStruct(ctx, target)
+	}
+
+	return false, nil
+}
+
+func (m resourceModel) autoFlexExpandToResourceStruct(ctx context.Context, target *resourceStruct) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !m.Name.IsNull() && !m.Name.IsUnknown() {
+		target.Name = m.Name.ValueStringPointer()
+	}
+	if !m.Description.IsNull() && !m.Description.IsUnknown() {
+		target.Description = m.Description.ValueString()
+	}
+	if !m.Comment.IsNull() && !m.Comment.IsUnknown() {
+		target.Comment = m.Comment.ValueStringPointer()
+	}
+	if !m.Enabled.IsNull() && !m.Enabled.IsUnknown() {
+		target.Enabled = m.Enabled.ValueBoolPointer()
+	}
+	if !m.Count.IsNull() && !m.Count.IsUnknown() {
+		target.Count = aws.Int32(int32(m.Count.ValueInt64()))
+	}
+	if !m.Size.IsNull() && !m.Size.IsUnknown() {
+		target.Size = m.Size.ValueInt64()
+	}
+	if !m.Weight.IsNull() && !m.Weight.IsUnknown() {
+		target.Weight = m.Weight.ValueInt32Pointer()
+	}
+	if !m.Ratio.IsNull() && !m.Ratio.IsUnknown() {
+		target.Ratio = m.Ratio.ValueFloat64Pointer()
+	}
+	if !m.Scale.IsNull() && !m.Scale.IsUnknown() {
+		target.Scale = float32(m.Scale.ValueFloat64())
+	}
+	if !m.Mode.IsNull() && !m.Mode.IsUnknown() {
+		target.Mode = testEnum(m.Mode.ValueString())
+	}
+	if !m.Alias.IsNull() && !m.Alias.IsUnknown() {
+		if v := m.Alias.ValueString(); v != "" {
+			target.Alias = aws.String(v)
+		}
+	}
+	if !m.Priority.IsNull() && !m.Priority.IsUnknown() {
+		if m.Priority.ValueInt64() != 0 {
+			target.Priority = m.Priority.ValueInt64Pointer()
+		}
+	}
+	diags.Append(fwflex.ExpandField(ctx, m.Values, &target.Value, false, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(fwflex.ExpandField(ctx, m.Setting, &target.Settings, false, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	return diags
+}
+
+var _ fwflex.GeneratedFlattener = (*resourceModel)(nil)
+
+func (m *resourceModel) AutoFlexFlattenFrom(ctx context.Context, source any) (bool, diag.Diagnostics) {
+	switch source := source.(type) {
+	case resourceStruct:
+		return true, m.autoFlexFlattenFromResourceStruct(ctx, &source)
+	}
+
+	return false, nil
+}
+
+func (m *resourceModel) autoFlexFlattenFromResourceStruct(ctx context.Context, v *resourceStruct) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Name = types.StringPointerValue(v.Name)
+	m.Description = types.StringValue(v.Description)
+	m.Comment = types.StringNull()
+	if v.Comment != nil && *v.Comment != "" {
+		m.Comment = types.StringValue(*v.Comment)
+	}
+	m.Enabled = types.BoolPointerValue(v.Enabled)
+	m.Count = types.Int64Null()
+	if v.Count != nil {
+		m.Count = types.Int64Value(int64(*v.Count))
+	}
+	m.Size = types.Int64Value(v.Size)
+	m.Weight = types.Int32PointerValue(v.Weight)
+	m.Ratio = types.Float64PointerValue(v.Ratio)
+	diags.Append(fwflex.FlattenField(ctx, &v.Scale, &m.Scale, false, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	m.Mode = fwtypes.StringEnumNull[testEnum]()
+	if v.Mode != "" {
+		m.Mode = fwtypes.StringEnumValue(testEnum(v.Mode))
+	}
+	m.Alias = types.StringValue(aws.ToString(v.Alias))
+	m.Priority = types.Int64Value(aws.ToInt64(v.Priority))
+	diags.Append(fwflex.FlattenField(ctx, &v.Value, &m.Values, false, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(fwflex.FlattenField(ctx, &v.Settings, &m.Setting, false, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	m.ReadOnlyState = types.StringPointerValue(v.ReadOnlyState)
+
+	return diags
+}
+
+var _ fwflex.GeneratedExpander = settingModel{}
+
+func (m settingModel) AutoFlexExpandTo(ctx context.Context, target any) (bool, diag.Diagnostics) {
+	switch target := target.(type) {
+	case *settingStruct:
+		return true, m.autoFlexExpandToSettingStruct(ctx, target)
+	}
+
+	return false, nil
+}
+
+func (m settingModel) autoFlexExpandToSettingStruct(ctx context.Context, target *settingStruct) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !m.Key.IsNull() && !m.Key.IsUnknown() {
+		target.Key = m.Key.ValueStringPointer()
+	}
+	if !m.Value.IsNull() && !m.Value.IsUnknown() {
+		target.Value = m.Value.ValueStringPointer()
+	}
+
+	return diags
+}
+
+var _ fwflex.GeneratedFlattener = (*settingModel)(nil)
+
+func (m *settingModel) AutoFlexFlattenFrom(ctx context.Context, source any) (bool, diag.Diagnostics) {
+	switch source := source.(type) {
+	case settingStruct:
+		return true, m.autoFlexFlattenFromSettingStruct(ctx, &source)
+	}
+
+	return false, nil
+}
+
+func (m *settingModel) autoFlexFlattenFromSettingStruct(ctx context.Context, v *settingStruct) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Key = types.StringPointerValue(v.Key)
+	m.Value = types.StringPointerValue(v.Value)
+
+	return diags
+}
@@ -0,0 +1,233 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package autoflextest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestGeneratedExpand(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		source resourceModel
+	}{
+		"zero": {
+			source: resourceModel{},
+		},
+		"null": {
+			source: resourceModel{
+				Name:          types.StringNull(),
+				Description:   types.StringNull(),
+				Comment:       types.StringNull(),
+				Enabled:       types.BoolNull(),
+				Count:         types.Int64Null(),
+				Size:          types.Int64Null(),
+				Weight:        types.Int32Null(),
+				Ratio:         types.Float64Null(),
+				Scale:         types.Float64Null(),
+				Mode:          fwtypes.StringEnumNull[testEnum](),
+				Alias:         types.StringNull(),
+				Priority:      types.Int64Null(),
+				Values:        fwtypes.NewListValueOfNull[types.String](ctx),
+				Setting:       fwtypes.NewListNestedObjectValueOfNull[settingModel](ctx),
+				Internal:      types.StringNull(),
+				ReadOnlyState: types.StringNull(),
+				Tags:          fwtypes.NewMapValueOfNull[types.String](ctx),
+			},
+		},
+		"unknown": {
+			source: resourceModel{
+				Name:     types.StringUnknown(),
+				Enabled:  types.BoolUnknown(),
+				Count:    types.Int64Unknown(),
+				Weight:   types.Int32Unknown(),
+				Ratio:    types.Float64Unknown(),
+				Mode:     fwtypes.StringEnumUnknown[testEnum](),
+				Values:   fwtypes.NewListValueOfUnknown[types.String](ctx),
+				Setting:  fwtypes.NewListNestedObjectValueOfUnknown[settingModel](ctx),
+				Priority: types.Int64Unknown(),
+			},
+		},
+		"empty values": {
+			source: resourceModel{
+				Name:        types.StringValue(""),
+				Description: types.StringValue(""),
+				Comment:     types.StringValue(""),
+				Enabled:     types.BoolValue(false),
+				Count:       types.Int64Value(0),
+				Size:        types.Int64Value(0),
+				Weight:      types.Int32Value(0),
+				Ratio:       types.Float64Value(0),
+				Scale:       types.Float64Value(0),
+				Alias:       types.StringValue(""),
+				Priority:    types.Int64Value(0),
+				Values:      fwtypes.NewListValueOfMust[types.String](ctx, []attr.Value{}),
+				Setting:     fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []settingModel{}),
+			},
+		},
+		"values": {
+			source: resourceModel{
+				Name:        types.StringValue("name"),
+				Description: types.StringValue("description"),
+				Comment:     types.StringValue("comment"),
+				Enabled:     types.BoolValue(true),
+				Count:       types.Int64Value(3),
+				Size:        types.Int64Value(1024),
+				Weight:      types.Int32Value(7),
+				Ratio:       types.Float64Value(0.5),
+				Scale:       types.Float64Value(1.25),
+				Mode:        fwtypes.StringEnumValue(testEnumBar),
+				Alias:       types.StringValue("alias"),
+				Priority:    types.Int64Value(10),
+				Values: fwtypes.NewListValueOfMust[types.String](ctx, []attr.Value{
+					types.StringValue("a"),
+					types.StringValue("b"),
+				}),
+				Setting: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []settingModel{
+					{Key: types.StringValue("k1"), Value: types.StringValue("v1")},
+					{Key: types.StringValue("k2"), Value: types.StringNull()},
+				}),
+				Internal:      types.StringValue("internal"),
+				ReadOnlyState: types.StringValue("state"),
+				Tags: fwtypes.NewMapValueOfMust[types.String](ctx, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got, want resourceStruct
+
+			if diags := fwflex.Expand(ctx, testCase.source, &got); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if diags := fwflex.Expand(ctx, testCase.source, &want, fwflex.WithoutGeneratedCode()); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratedFlatten(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		source resourceStruct
+	}{
+		"zero": {
+			source: resourceStruct{},
+		},
+		"empty values": {
+			source: resourceStruct{
+				Name:          aws.String(""),
+				Comment:       aws.String(""),
+				Enabled:       aws.Bool(false),
+				Count:         aws.Int32(0),
+				Weight:        aws.Int32(0),
+				Ratio:         aws.Float64(0),
+				Alias:         aws.String(""),
+				Priority:      aws.Int64(0),
+				Value:         []string{},
+				Settings:      []settingStruct{},
+				Internal:      aws.String(""),
+				ReadOnlyState: aws.String(""),
+				Tags:          map[string]string{},
+			},
+		},
+		"values": {
+			source: resourceStruct{
+				Name:        aws.String("name"),
+				Description: "description",
+				Comment:     aws.String("comment"),
+				Enabled:     aws.Bool(true),
+				Count:       aws.Int32(3),
+				Size:        1024,
+				Weight:      aws.Int32(7),
+				Ratio:       aws.Float64(0.5),
+				Scale:       1.1,
+				Mode:        testEnumFoo,
+				Alias:       aws.String("alias"),
+				Priority:    aws.Int64(10),
+				Value:       []string{"a", "b"},
+				Settings: []settingStruct{
+					{Key: aws.String("k1"), Value: aws.String("v1")},
+					{Key: aws.String("k2")},
+				},
+				Internal:      aws.String("internal"),
+				ReadOnlyState: aws.String("state"),
+				Tags: map[string]string{
+					"key": "value",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Tags are not flattened. Zero-value maps never compare as equal.
+			got := resourceModel{Tags: fwtypes.NewMapValueOfNull[types.String](ctx)}
+			want := resourceModel{Tags: fwtypes.NewMapValueOfNull[types.String](ctx)}
+
+			if diags := fwflex.Flatten(ctx, testCase.source, &got); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if diags := fwflex.Flatten(ctx, testCase.source, &want, fwflex.WithoutGeneratedCode()); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratedCodeIsUsed(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	model := resourceModel{
+		Name: types.StringValue("name"),
+	}
+	var apiObject resourceStruct
+
+	ok, diags := model.AutoFlexExpandTo(ctx, &apiObject)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !ok {
+		t.Fatal("expected generated code for *resourceStruct")
+	}
+	if got, want := aws.ToString(apiObject.Name), "name"; got != want {
+		t.Errorf("Name = %q, want %q", got, want)
+	}
+
+	if ok, _ := model.AutoFlexExpandTo(ctx, &settingStruct{}); ok {
+		t.Error("expected no generated code for *settingStruct")
+	}
+}
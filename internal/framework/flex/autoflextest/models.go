@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package autoflextest contains models with generated AutoFlex conversion code,
+// used to verify that generated and reflective AutoFlex results are the same.
+package autoflextest
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type testEnum string
+
+const (
+	testEnumFoo testEnum = "foo"
+	testEnumBar testEnum = "bar"
+)
+
+func (testEnum) Values() []testEnum {
+	return []testEnum{
+		testEnumFoo,
+		testEnumBar,
+	}
+}
+
+type resourceModel struct {
+	Name          types.String                                  `tfsdk:"name"`
+	Description   types.String                                  `tfsdk:"description"`
+	Comment       types.String                                  `tfsdk:"comment" autoflex:",omitempty"`
+	Enabled       types.Bool                                    `tfsdk:"enabled"`
+	Count         types.Int64                                   `tfsdk:"count"`
+	Size          types.Int64                                   `tfsdk:"size"`
+	Weight        types.Int32                                   `tfsdk:"weight"`
+	Ratio         types.Float64                                 `tfsdk:"ratio"`
+	Scale         types.Float64                                 `tfsdk:"scale"`
+	Mode          fwtypes.StringEnum[testEnum]                  `tfsdk:"mode"`
+	Alias         types.String                                  `tfsdk:"alias" autoflex:",legacy"`
+	Priority      types.Int64                                   `tfsdk:"priority" autoflex:",legacy"`
+	Values        fwtypes.ListValueOf[types.String]             `tfsdk:"values"`
+	Setting       fwtypes.ListNestedObjectValueOf[settingModel] `tfsdk:"setting"`
+	Internal      types.String                                  `tfsdk:"internal" autoflex:"-"`
+	ReadOnlyState types.String                                  `tfsdk:"read_only_state" autoflex:",noexpand"`
+	Tags          fwtypes.MapOfString                           `tfsdk:"tags"`
+}
+
+type settingModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+type resourceStruct struct {
+	Name          *string
+	Description   string
+	Comment       *string
+	Enabled       *bool
+	Count         *int32
+	Size          int64
+	Weight        *int32
+	Ratio         *float64
+	Scale         float32
+	Mode          testEnum
+	Alias         *string
+	Priority      *int64
+	Value         []string
+	Settings      []settingStruct
+	Internal      *string
+	ReadOnlyState *string
+	Tags          map[string]string
+}
+
+type settingStruct struct {
+	Key   *string
+	Value *string
+}
@@ -27,6 +27,10 @@ type AutoFlexOptions struct {
 	// ignoredFieldNames stores names which expanders and flatteners will
 	// not read from or write to
 	ignoredFieldNames []string
+
+	// useGeneratedCode specifies whether generated conversion code is used
+	// for types that implement GeneratedExpander or GeneratedFlattener
+	useGeneratedCode bool
 }
 
 // WithFieldNamePrefix specifies a prefix to be accounted for when
@@ -77,6 +81,17 @@ func WithNoIgnoredFieldNames() AutoFlexOptionsFunc {
 	}
 }
 
+// WithoutGeneratedCode disables the use of generated conversion code
+//
+// Generated conversion code is only used when no options are specified,
+// so this option is mainly useful for comparing generated and reflective
+// AutoFlex results in tests.
+func WithoutGeneratedCode() AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.useGeneratedCode = false
+	}
+}
+
 // isIgnoredField returns true if s is in the list of ignored field names
 func (o *AutoFlexOptions) isIgnoredField(s string) bool {
 	return slices.Contains(o.ignoredFieldNames, s)
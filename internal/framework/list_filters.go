@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"strings"
+
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// ListNamePrefixFilterAttribute returns the schema for a list resource "name_prefix" filter.
+// Only resources whose names begin with the specified prefix are listed.
+func ListNamePrefixFilterAttribute() listschema.StringAttribute {
+	return listschema.StringAttribute{
+		Optional:    true,
+		Description: "Name prefix. Only resources whose names begin with this prefix are listed.",
+	}
+}
+
+// MatchesNamePrefixFilter returns whether or not the resource name begins with the filter prefix.
+func MatchesNamePrefixFilter(filter types.String, name string) bool {
+	return strings.HasPrefix(name, filter.ValueString())
+}
+
+// ListTagsFilterAttribute returns the schema for a list resource "tags" filter.
+// Only resources having all the specified tags are listed.
+func ListTagsFilterAttribute() listschema.MapAttribute {
+	return listschema.MapAttribute{
+		CustomType:  tftags.MapType,
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "Map of tags. Only resources having all the specified tags are listed.",
+	}
+}
+
+// MatchesTagsFilter returns whether or not the resource tags include all the filter tags.
+func MatchesTagsFilter(ctx context.Context, filter tftags.Map, tags tftags.KeyValueTags) bool {
+	return tags.ContainsAll(tftags.New(ctx, filter))
+}
@@ -64,6 +64,20 @@ func (l *ListResourceWithSDKv2Resource) SetRegionSpec(regionSpec unique.Handle[i
 				l.resourceSchema.Schema[names.AttrRegion] = regionSchema
 			}
 		}
+		if _, ok := l.resourceSchema.SchemaMap()[names.AttrAssumeRole]; !ok {
+			// Inject a top-level "assume_role" attribute.
+			assumeRoleSchema := sdkv2.AssumeRoleOptional()
+
+			if f := l.resourceSchema.SchemaFunc; f != nil {
+				l.resourceSchema.SchemaFunc = func() map[string]*schema.Schema {
+					s := f()
+					s[names.AttrAssumeRole] = assumeRoleSchema
+					return s
+				}
+			} else {
+				l.resourceSchema.Schema[names.AttrAssumeRole] = assumeRoleSchema
+			}
+		}
 	}
 }
 
@@ -92,6 +106,13 @@ func (l *ListResourceWithSDKv2Resource) SetIdentitySpec(identitySpec inttypes.Id
 			out[v.Name()].Optional = true
 		}
 	}
+	if !tfunique.IsHandleNil(l.regionSpec) && l.regionSpec.Value().IsOverrideEnabled {
+		// Inject a top-level "assume_role" identity attribute.
+		out[names.AttrAssumeRole] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		}
+	}
 
 	identitySchema := schema.ResourceIdentity{
 		SchemaFunc: func() map[string]*schema.Schema {
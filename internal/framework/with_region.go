@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// WithRegionModel holds the top-level attributes injected into the schema of every Region-aware resource and data source.
 type WithRegionModel struct {
-	Region types.String `tfsdk:"region"`
+	AssumeRole types.String `tfsdk:"assume_role"`
+	Region     types.String `tfsdk:"region"`
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 // WithTimeouts is intended to be embedded in resources which use the special "timeouts" nested block.
@@ -38,8 +39,14 @@ func (w *WithTimeouts) SetDefaultDeleteTimeout(timeout time.Duration) {
 }
 
 // CreateTimeout returns any configured Create timeout value or the default value.
+// Any provider-level default Create timeout for the resource type takes precedence over the resource's default.
 func (w *WithTimeouts) CreateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Create(ctx, w.defaultCreateTimeout)
+	defaultTimeout := w.defaultCreateTimeout
+	if v := conns.DefaultTimeoutsFromContext(ctx).Create; v > 0 {
+		defaultTimeout = v
+	}
+
+	timeout, diags := timeouts.Create(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Create timeout", map[string]any{
@@ -47,7 +54,7 @@ func (w *WithTimeouts) CreateTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultCreateTimeout
+		return defaultTimeout
 	}
 
 	return timeout
@@ -70,8 +77,14 @@ func (w *WithTimeouts) ReadTimeout(ctx context.Context, timeouts timeouts.Value)
 }
 
 // UpdateTimeout returns any configured Update timeout value or the default value.
+// Any provider-level default Update timeout for the resource type takes precedence over the resource's default.
 func (w *WithTimeouts) UpdateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Update(ctx, w.defaultUpdateTimeout)
+	defaultTimeout := w.defaultUpdateTimeout
+	if v := conns.DefaultTimeoutsFromContext(ctx).Update; v > 0 {
+		defaultTimeout = v
+	}
+
+	timeout, diags := timeouts.Update(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Update timeout", map[string]any{
@@ -79,15 +92,21 @@ func (w *WithTimeouts) UpdateTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultUpdateTimeout
+		return defaultTimeout
 	}
 
 	return timeout
 }
 
 // DeleteTimeout returns any configured Delete timeout value or the default value.
+// Any provider-level default Delete timeout for the resource type takes precedence over the resource's default.
 func (w *WithTimeouts) DeleteTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Delete(ctx, w.defaultDeleteTimeout)
+	defaultTimeout := w.defaultDeleteTimeout
+	if v := conns.DefaultTimeoutsFromContext(ctx).Delete; v > 0 {
+		defaultTimeout = v
+	}
+
+	timeout, diags := timeouts.Delete(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Delete timeout", map[string]any{
@@ -95,7 +114,7 @@ func (w *WithTimeouts) DeleteTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultDeleteTimeout
+		return defaultTimeout
 	}
 
 	return timeout
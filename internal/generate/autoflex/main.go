@@ -0,0 +1,708 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	"cmp"
+	_ "embed"
+	"flag"
+	"fmt"
+	"go/types"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"golang.org/x/tools/go/packages"
+)
+
+var (
+	expandTypes  = flag.String("Expand", "", "comma-separated list of <model>:<AWS type> pairs to generate expansion code for")
+	flattenTypes = flag.String("Flatten", "", "comma-separated list of <model>:<AWS type> pairs to generate flattening code for")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+const (
+	awsPackagePath       = "github.com/aws/aws-sdk-go-v2/aws"
+	basetypesPackagePath = "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	flexPackagePath      = "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypesPackagePath   = "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	typesPackagePath     = "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	// Methods which customize or take over conversion of a model.
+	hookMethodNames = []string{"AutoFlexExpandTo", "AutoFlexFlattenFrom", "Expand", "ExpandTo", "Flatten"}
+)
+
+type TemplateData struct {
+	Parameters  string
+	PackageName string
+	Imports     []Import
+	Models      []*Model
+}
+
+type Import struct {
+	Alias string
+	Path  string
+}
+
+type Model struct {
+	Name       string
+	Expanders  []*Conversion
+	Flatteners []*Conversion
+}
+
+type Conversion struct {
+	AWSType  string
+	FuncName string
+	Fields   []string
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	g := common.NewGenerator()
+
+	if *expandTypes == "" && *flattenTypes == "" {
+		g.Fatalf("at least one of Expand or Flatten must be specified")
+	}
+
+	filename := "autoflex_gen.go"
+	if args := flag.Args(); len(args) > 0 {
+		filename = args[0]
+	}
+
+	packageName := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating %s/%s", packageName, filename)
+
+	pkg, err := loadPackage(packageName, filename)
+	if err != nil {
+		g.Fatalf("loading package: %s", err)
+	}
+
+	gen := newCodeGenerator(pkg)
+
+	for pair := range pairs(*expandTypes) {
+		if err := gen.addExpander(pair[0], pair[1]); err != nil {
+			g.Fatalf("generating expander (%s:%s): %s", pair[0], pair[1], err)
+		}
+	}
+	for pair := range pairs(*flattenTypes) {
+		if err := gen.addFlattener(pair[0], pair[1]); err != nil {
+			g.Fatalf("generating flattener (%s:%s): %s", pair[0], pair[1], err)
+		}
+	}
+
+	templateData := TemplateData{
+		Parameters:  strings.Join(os.Args[1:], " "),
+		PackageName: packageName,
+		Imports:     gen.imports(),
+		Models:      gen.models,
+	}
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.BufferTemplate("autoflex", fileTemplate, templateData); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+//go:embed file.gtpl
+var fileTemplate string
+
+// pairs returns an iterator over the <model>:<AWS type> pairs in a flag value.
+func pairs(s string) func(func([2]string) bool) {
+	return func(yield func([2]string) bool) {
+		if s == "" {
+			return
+		}
+		for v := range strings.SplitSeq(s, ",") {
+			model, awsType, _ := strings.Cut(strings.TrimSpace(v), ":")
+			if !yield([2]string{model, awsType}) {
+				return
+			}
+		}
+	}
+}
+
+// loadPackage loads and type checks the package in the current directory.
+// Any previously generated file is replaced by an empty file, so stale generated code does not affect the result.
+func loadPackage(packageName, filename string) (*packages.Package, error) {
+	path, err := filepath.Abs(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo,
+		Overlay: map[string][]byte{
+			path: []byte("package " + packageName + "\n"),
+		},
+	}
+	pkgs, err := packages.Load(cfg, ".")
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("%d packages found", len(pkgs))
+	}
+
+	pkg := pkgs[0]
+	if len(pkg.Errors) > 0 {
+		return nil, pkg.Errors[0]
+	}
+
+	return pkg, nil
+}
+
+type codeGenerator struct {
+	pkg           *packages.Package
+	aliases       map[string]string // Package path to import alias.
+	usedImports   map[string]string // Import alias to package path.
+	models        []*Model
+	matcher       *common.AutoFlexFieldMatcher
+	conversionFns map[string]bool
+}
+
+func newCodeGenerator(pkg *packages.Package) *codeGenerator {
+	gen := &codeGenerator{
+		pkg:           pkg,
+		aliases:       make(map[string]string),
+		usedImports:   make(map[string]string),
+		matcher:       common.NewAutoFlexFieldMatcher(),
+		conversionFns: make(map[string]bool),
+	}
+
+	// Use the same import aliases as the package's source files.
+	for _, file := range pkg.Syntax {
+		for _, spec := range file.Imports {
+			if spec.Name == nil || spec.Name.Name == "_" || spec.Name.Name == "." {
+				continue
+			}
+			path, _ := strconv.Unquote(spec.Path.Value)
+			if _, ok := gen.aliases[path]; !ok {
+				gen.aliases[path] = spec.Name.Name
+			}
+		}
+	}
+	for path, alias := range map[string]string{
+		flexPackagePath:    "fwflex",
+		fwtypesPackagePath: "fwtypes",
+	} {
+		if _, ok := gen.aliases[path]; !ok {
+			gen.aliases[path] = alias
+		}
+	}
+
+	return gen
+}
+
+// qualifier returns the name used to refer to a package in generated code, recording the import.
+func (gen *codeGenerator) qualifier(pkg *types.Package) string {
+	if pkg.Path() == gen.pkg.PkgPath {
+		return ""
+	}
+
+	alias, ok := gen.aliases[pkg.Path()]
+	if !ok {
+		alias = pkg.Name()
+	}
+	gen.usedImports[alias] = pkg.Path()
+
+	return alias
+}
+
+func (gen *codeGenerator) typeString(typ types.Type) string {
+	return types.TypeString(typ, gen.qualifier)
+}
+
+// use records the use of one of the well-known packages and returns its qualifier.
+func (gen *codeGenerator) use(path string) string {
+	return gen.qualifier(types.NewPackage(path, filepath.Base(path)))
+}
+
+func (gen *codeGenerator) imports() []Import {
+	var imports []Import
+
+	for alias, path := range gen.usedImports {
+		if alias == filepath.Base(path) {
+			alias = ""
+		}
+		imports = append(imports, Import{Alias: alias, Path: path})
+	}
+	slices.SortFunc(imports, func(a, b Import) int {
+		return cmp.Compare(a.Path, b.Path)
+	})
+
+	return imports
+}
+
+// lookupType resolves a type name, optionally qualified by an import alias used in the package.
+func (gen *codeGenerator) lookupType(name string) (*types.Named, error) {
+	var obj types.Object
+
+	if qualifier, typeName, ok := strings.Cut(name, "."); ok {
+		path := gen.importPath(qualifier)
+		if path == "" {
+			return nil, fmt.Errorf("package %q is not imported", qualifier)
+		}
+		for _, pkg := range gen.pkg.Types.Imports() {
+			if pkg.Path() == path {
+				obj = pkg.Scope().Lookup(typeName)
+				break
+			}
+		}
+	} else {
+		obj = gen.pkg.Types.Scope().Lookup(name)
+	}
+
+	if obj == nil {
+		return nil, fmt.Errorf("type %q not found", name)
+	}
+	typeName, ok := obj.(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a type", name)
+	}
+	named, ok := types.Unalias(typeName.Type()).(*types.Named)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a named type", name)
+	}
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		return nil, fmt.Errorf("%q is not a struct type", name)
+	}
+
+	return named, nil
+}
+
+// importPath returns the path of the package imported with the specified name.
+func (gen *codeGenerator) importPath(name string) string {
+	for _, file := range gen.pkg.Syntax {
+		for _, spec := range file.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			if spec.Name != nil {
+				if spec.Name.Name == name {
+					return path
+				}
+				continue
+			}
+			for _, pkg := range gen.pkg.Types.Imports() {
+				if pkg.Path() == path && pkg.Name() == name {
+					return path
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+func (gen *codeGenerator) model(named *types.Named) (*Model, error) {
+	name := named.Obj().Name()
+
+	for _, m := range gen.models {
+		if m.Name == name {
+			return m, nil
+		}
+	}
+
+	if named.Obj().Pkg() != gen.pkg.Types {
+		return nil, fmt.Errorf("model %q is not declared in package %s", name, gen.pkg.Name)
+	}
+	methods := types.NewMethodSet(types.NewPointer(named))
+	for _, methodName := range hookMethodNames {
+		if methods.Lookup(named.Obj().Pkg(), methodName) != nil {
+			return nil, fmt.Errorf("model %q already has a %s method", name, methodName)
+		}
+	}
+
+	m := &Model{Name: name}
+	gen.models = append(gen.models, m)
+
+	return m, nil
+}
+
+func (gen *codeGenerator) conversionFuncName(model *types.Named, prefix string, awsType *types.Named) (string, error) {
+	typeName := awsType.Obj().Name()
+	name := prefix + strings.ToUpper(typeName[:1]) + typeName[1:]
+
+	key := model.Obj().Name() + "." + name
+	if gen.conversionFns[key] {
+		return "", fmt.Errorf("duplicate conversion %s", key)
+	}
+	gen.conversionFns[key] = true
+
+	return name, nil
+}
+
+func (gen *codeGenerator) addExpander(modelName, awsTypeName string) error {
+	model, err := gen.lookupType(modelName)
+	if err != nil {
+		return err
+	}
+	awsType, err := gen.lookupType(awsTypeName)
+	if err != nil {
+		return err
+	}
+	if err := checkXMLWrappers(model, awsType); err != nil {
+		return err
+	}
+
+	m, err := gen.model(model)
+	if err != nil {
+		return err
+	}
+	funcName, err := gen.conversionFuncName(model, "autoFlexExpandTo", awsType)
+	if err != nil {
+		return err
+	}
+
+	conversion := &Conversion{
+		AWSType:  gen.typeString(awsType),
+		FuncName: funcName,
+	}
+
+	for _, mapping := range gen.matcher.ExpandMappings(model, awsType) {
+		if mapping.Target == nil {
+			continue
+		}
+
+		_, opts := mapping.Source.AutoFlexTag()
+		conversion.Fields = append(conversion.Fields, gen.expandField(mapping.Source, *mapping.Target, opts))
+	}
+
+	m.Expanders = append(m.Expanders, conversion)
+
+	return nil
+}
+
+func (gen *codeGenerator) addFlattener(modelName, awsTypeName string) error {
+	model, err := gen.lookupType(modelName)
+	if err != nil {
+		return err
+	}
+	awsType, err := gen.lookupType(awsTypeName)
+	if err != nil {
+		return err
+	}
+	if err := checkXMLWrappers(model, awsType); err != nil {
+		return err
+	}
+
+	m, err := gen.model(model)
+	if err != nil {
+		return err
+	}
+	funcName, err := gen.conversionFuncName(model, "autoFlexFlattenFrom", awsType)
+	if err != nil {
+		return err
+	}
+
+	conversion := &Conversion{
+		AWSType:  gen.typeString(awsType),
+		FuncName: funcName,
+	}
+
+	for _, mapping := range gen.matcher.FlattenMappings(awsType, model) {
+		if mapping.Target == nil {
+			continue
+		}
+
+		_, opts := mapping.Target.AutoFlexTag()
+		conversion.Fields = append(conversion.Fields, gen.flattenField(mapping.Source, *mapping.Target, opts))
+	}
+
+	m.Flatteners = append(m.Flatteners, conversion)
+
+	return nil
+}
+
+func (gen *codeGenerator) expandField(from, to common.StructField, opts common.AutoFlexTagOptions) string {
+	var native string
+
+	legacy := opts.Contains("legacy")
+	source, target := "m."+from.Name(), "target."+to.Name()
+
+	switch fromType, toType := from.Var.Type(), to.Var.Type(); frameworkPrimitive(fromType) {
+	case "String":
+		value := source + ".ValueString()"
+		switch {
+		case isBasic(toType, types.IsString):
+			native = fmt.Sprintf("%s = %s", target, gen.convert(value, types.Typ[types.String], toType))
+		case isPointerTo(toType, types.String):
+			if legacy {
+				native = fmt.Sprintf("if v := %[1]s; v != \"\" {\n%[2]s = %[3]s.String(v)\n}", value, target, gen.use(awsPackagePath))
+			} else {
+				native = fmt.Sprintf("%s = %s.ValueStringPointer()", target, source)
+			}
+		}
+
+	case "Bool":
+		switch {
+		case isBasic(toType, types.IsBoolean):
+			native = fmt.Sprintf("%s = %s", target, gen.convert(source+".ValueBool()", types.Typ[types.Bool], toType))
+		case isPointerTo(toType, types.Bool):
+			if legacy {
+				native = fmt.Sprintf("if %[1]s.ValueBool() {\n%[2]s = %[1]s.ValueBoolPointer()\n}", source, target)
+			} else {
+				native = fmt.Sprintf("%s = %s.ValueBoolPointer()", target, source)
+			}
+		}
+
+	case "Int64":
+		value := source + ".ValueInt64()"
+		switch {
+		case isBasicKind(toType, types.Int32, types.Int64):
+			native = fmt.Sprintf("%s = %s", target, gen.convert(value, types.Typ[types.Int64], toType))
+		case isPointerTo(toType, types.Int32):
+			if legacy {
+				native = fmt.Sprintf("if v := int32(%[1]s); v != 0 {\n%[2]s = %[3]s.Int32(v)\n}", value, target, gen.use(awsPackagePath))
+			} else {
+				native = fmt.Sprintf("%s = %s.Int32(int32(%s))", target, gen.use(awsPackagePath), value)
+			}
+		case isPointerTo(toType, types.Int64):
+			if legacy {
+				native = fmt.Sprintf("if %[1]s != 0 {\n%[2]s = %[3]s.ValueInt64Pointer()\n}", value, target, source)
+			} else {
+				native = fmt.Sprintf("%s = %s.ValueInt64Pointer()", target, source)
+			}
+		}
+
+	case "Int32":
+		value := source + ".ValueInt32()"
+		switch {
+		case isBasicKind(toType, types.Int32):
+			native = fmt.Sprintf("%s = %s", target, gen.convert(value, types.Typ[types.Int32], toType))
+		case isPointerTo(toType, types.Int32):
+			if legacy {
+				native = fmt.Sprintf("if %[1]s != 0 {\n%[2]s = %[3]s.ValueInt32Pointer()\n}", value, target, source)
+			} else {
+				native = fmt.Sprintf("%s = %s.ValueInt32Pointer()", target, source)
+			}
+		}
+
+	case "Float64":
+		value := source + ".ValueFloat64()"
+		switch {
+		case isBasicKind(toType, types.Float32, types.Float64):
+			native = fmt.Sprintf("%s = %s", target, gen.convert(value, types.Typ[types.Float64], toType))
+		case isPointerTo(toType, types.Float32):
+			if legacy {
+				native = fmt.Sprintf("if v := float32(%[1]s); v != 0 {\n%[2]s = %[3]s.Float32(v)\n}", value, target, gen.use(awsPackagePath))
+			} else {
+				native = fmt.Sprintf("%s = %s.Float32(float32(%s))", target, gen.use(awsPackagePath), value)
+			}
+		case isPointerTo(toType, types.Float64):
+			if legacy {
+				native = fmt.Sprintf("if %[1]s != 0 {\n%[2]s = %[3]s.ValueFloat64Pointer()\n}", value, target, source)
+			} else {
+				native = fmt.Sprintf("%s = %s.ValueFloat64Pointer()", target, source)
+			}
+		}
+	}
+
+	if native != "" {
+		return fmt.Sprintf("if !%[1]s.IsNull() && !%[1]s.IsUnknown() {\n%[2]s\n}", source, native)
+	}
+
+	return fmt.Sprintf("diags.Append(%s.ExpandField(ctx, %s, &%s, %t, %t)...)\nif diags.HasError() {\nreturn diags\n}", gen.use(flexPackagePath), source, target, legacy, opts.Contains("omitempty"))
+}
+
+func (gen *codeGenerator) flattenField(from, to common.StructField, opts common.AutoFlexTagOptions) string {
+	var value string
+
+	legacy, omitempty := opts.Contains("legacy"), opts.Contains("omitempty")
+	source, target := "v."+from.Name(), "m."+to.Name()
+	typesPkg := gen.use(typesPackagePath)
+
+	switch fromType, toType := from.Var.Type(), to.Var.Type(); {
+	case isNamed(toType, basetypesPackagePath, "StringValue"):
+		switch {
+		case isBasic(fromType, types.IsString):
+			value = fmt.Sprintf("%s.StringValue(%s)", typesPkg, gen.convert(source, fromType, types.Typ[types.String]))
+			if !legacy && omitempty {
+				value = fmt.Sprintf("%[3]s.StringNull()\nif %[1]s != \"\" {\n%[2]s = %[4]s\n}", source, target, typesPkg, value)
+			}
+		case isPointerTo(fromType, types.String):
+			switch {
+			case legacy:
+				value = fmt.Sprintf("%s.StringValue(%s.ToString(%s))", typesPkg, gen.use(awsPackagePath), source)
+			case omitempty:
+				value = fmt.Sprintf("%[3]s.StringNull()\nif %[1]s != nil && *%[1]s != \"\" {\n%[2]s = %[3]s.StringValue(*%[1]s)\n}", source, target, typesPkg)
+			default:
+				value = fmt.Sprintf("%s.StringPointerValue(%s)", typesPkg, source)
+			}
+		}
+
+	case isNamed(toType, fwtypesPackagePath, "StringEnum"):
+		enumType := gen.typeString(toType.(*types.Named).TypeArgs().At(0))
+		fwtypesPkg := gen.use(fwtypesPackagePath)
+		if isBasic(fromType, types.IsString) {
+			value = fmt.Sprintf("%s.StringEnumValue(%s(%s))", fwtypesPkg, enumType, source)
+			if !legacy {
+				value = fmt.Sprintf("%[3]s.StringEnumNull[%[4]s]()\nif %[1]s != \"\" {\n%[2]s = %[5]s\n}", source, target, fwtypesPkg, enumType, value)
+			}
+		}
+
+	case isNamed(toType, basetypesPackagePath, "BoolValue"):
+		switch {
+		case isBasic(fromType, types.IsBoolean):
+			value = fmt.Sprintf("%s.BoolValue(%s)", typesPkg, gen.convert(source, fromType, types.Typ[types.Bool]))
+		case isPointerTo(fromType, types.Bool):
+			if legacy {
+				value = fmt.Sprintf("%s.BoolValue(%s.ToBool(%s))", typesPkg, gen.use(awsPackagePath), source)
+			} else {
+				value = fmt.Sprintf("%s.BoolPointerValue(%s)", typesPkg, source)
+			}
+		}
+
+	case isNamed(toType, basetypesPackagePath, "Int64Value"):
+		switch {
+		case isBasicKind(fromType, types.Int32, types.Int64):
+			value = fmt.Sprintf("%s.Int64Value(%s)", typesPkg, gen.convert(source, fromType, types.Typ[types.Int64]))
+		case isPointerTo(fromType, types.Int32):
+			if legacy {
+				value = fmt.Sprintf("%s.Int64Value(int64(%s.ToInt32(%s)))", typesPkg, gen.use(awsPackagePath), source)
+			} else {
+				value = fmt.Sprintf("%[3]s.Int64Null()\nif %[1]s != nil {\n%[2]s = %[3]s.Int64Value(int64(*%[1]s))\n}", source, target, typesPkg)
+			}
+		case isPointerTo(fromType, types.Int64):
+			if legacy {
+				value = fmt.Sprintf("%s.Int64Value(%s.ToInt64(%s))", typesPkg, gen.use(awsPackagePath), source)
+			} else {
+				value = fmt.Sprintf("%s.Int64PointerValue(%s)", typesPkg, source)
+			}
+		}
+
+	case isNamed(toType, basetypesPackagePath, "Int32Value"):
+		switch {
+		case isBasicKind(fromType, types.Int32):
+			value = fmt.Sprintf("%s.Int32Value(%s)", typesPkg, gen.convert(source, fromType, types.Typ[types.Int32]))
+		case isPointerTo(fromType, types.Int32):
+			if legacy {
+				value = fmt.Sprintf("%s.Int32Value(%s.ToInt32(%s))", typesPkg, gen.use(awsPackagePath), source)
+			} else {
+				value = fmt.Sprintf("%s.Int32PointerValue(%s)", typesPkg, source)
+			}
+		}
+
+	case isNamed(toType, basetypesPackagePath, "Float64Value"):
+		switch {
+		case isBasicKind(fromType, types.Float64):
+			value = fmt.Sprintf("%s.Float64Value(%s)", typesPkg, gen.convert(source, fromType, types.Typ[types.Float64]))
+		case isPointerTo(fromType, types.Float64):
+			if legacy {
+				value = fmt.Sprintf("%s.Float64Value(%s.ToFloat64(%s))", typesPkg, gen.use(awsPackagePath), source)
+			} else {
+				value = fmt.Sprintf("%s.Float64PointerValue(%s)", typesPkg, source)
+			}
+		}
+	}
+
+	if value == "" {
+		return fmt.Sprintf("diags.Append(%s.FlattenField(ctx, &%s, &%s, %t, %t)...)\nif diags.HasError() {\nreturn diags\n}", gen.use(flexPackagePath), source, target, legacy, omitempty)
+	}
+
+	return fmt.Sprintf("%s = %s", target, value)
+}
+
+// convert returns an expression converting a value of one basic type, or a named type with a basic underlying type, to another.
+func (gen *codeGenerator) convert(value string, from, to types.Type) string {
+	if types.Identical(from, to) {
+		return value
+	}
+
+	return fmt.Sprintf("%s(%s)", gen.typeString(to), value)
+}
+
+// frameworkPrimitive returns the name of the primitive Plugin Framework value type of the specified type, if any.
+func frameworkPrimitive(typ types.Type) string {
+	for _, name := range []string{"Bool", "Float64", "Int32", "Int64", "String"} {
+		if isNamed(typ, basetypesPackagePath, name+"Value") {
+			return name
+		}
+	}
+
+	if isNamed(typ, fwtypesPackagePath, "StringEnum") {
+		return "String"
+	}
+
+	return ""
+}
+
+func isNamed(typ types.Type, pkgPath, name string) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Origin().Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == pkgPath && obj.Name() == name
+}
+
+// isBasic returns whether the specified type is a basic type, or a named type with a basic underlying type, with the specified info.
+func isBasic(typ types.Type, info types.BasicInfo) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+
+	return ok && basic.Info()&info != 0
+}
+
+// isBasicKind returns whether the specified type is a basic type, or a named type with a basic underlying type, of one of the specified kinds.
+func isBasicKind(typ types.Type, kinds ...types.BasicKind) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+
+	return ok && slices.Contains(kinds, basic.Kind())
+}
+
+// isPointerTo returns whether the specified type is a pointer to the specified (unnamed) basic type.
+func isPointerTo(typ types.Type, kind types.BasicKind) bool {
+	ptr, ok := types.Unalias(typ).(*types.Pointer)
+	if !ok {
+		return false
+	}
+	basic, ok := types.Unalias(ptr.Elem()).(*types.Basic)
+
+	return ok && basic.Kind() == kind
+}
+
+// checkXMLWrappers returns an error if conversion between the types may require special handling of XML wrapper structs,
+// which is not supported by generated code.
+func checkXMLWrappers(model, awsType *types.Named) error {
+	for field := range common.StructFields(model) {
+		if _, opts := field.AutoFlexTag(); opts.HasPrefix("xmlwrapper") {
+			return fmt.Errorf("field %s.%s: xmlwrapper is not supported", model.Obj().Name(), field.Name())
+		}
+	}
+
+	if common.IsXMLWrapper(awsType) {
+		return fmt.Errorf("%s is an XML wrapper struct", awsType.Obj().Name())
+	}
+	for field := range common.StructFields(awsType) {
+		typ := field.Var.Type()
+		if ptr, ok := typ.(*types.Pointer); ok {
+			typ = ptr.Elem()
+		}
+		if common.IsXMLWrapper(typ) {
+			return fmt.Errorf("field %s.%s: XML wrapper structs are not supported", awsType.Obj().Name(), field.Name())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,706 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	"cmp"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"golang.org/x/tools/go/packages"
+)
+
+var (
+	lint    = flag.Bool("Lint", false, "exit with a non-zero status if any model field is never mapped or any mapped field's types do not match")
+	verbose = flag.Bool("Verbose", false, "also report fields which are mapped")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<package-pattern> ...]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+const (
+	basetypesPackagePath = "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	flexPackagePath      = "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	frameworkPackagePath = "github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypesPackagePath   = "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	timeoutsPackagePath  = "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	g := common.NewGenerator()
+
+	patterns := flag.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"./internal/service/..."}
+	}
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo,
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		g.Fatalf("loading packages: %s", err)
+	}
+
+	var problems int
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			g.Warnf("skipping package %s: %s", pkg.PkgPath, pkg.Errors[0])
+			continue
+		}
+
+		for _, report := range newPackageAnalyzer(pkg).analyze() {
+			report.print(os.Stdout, *verbose)
+			problems += report.problems()
+		}
+	}
+
+	if *lint && problems > 0 {
+		g.Fatalf("%d AutoFlex mapping problems found", problems)
+	}
+}
+
+// A conversion is a call to flex.Expand or flex.Flatten, or a conversion of a nested object implied by one.
+type conversion struct {
+	expand   bool
+	model    *types.Named
+	awsType  *types.Named
+	position string
+	mappings []common.AutoFlexFieldMapping
+}
+
+func (c *conversion) String() string {
+	if c.expand {
+		return fmt.Sprintf("Expand %s -> %s", typeName(c.model), typeName(c.awsType))
+	}
+
+	return fmt.Sprintf("Flatten %s -> %s", typeName(c.awsType), typeName(c.model))
+}
+
+// A report describes the AutoFlex field mappings for a resource's model, including any nested models.
+type report struct {
+	resource    string
+	model       *types.Named
+	conversions []*conversion
+	skipped     []string // Calls which could not be analyzed.
+	unmapped    []string // Model fields which are never mapped and never referenced.
+	manual      []string // Model fields which are never mapped but are referenced.
+	ignored     []string
+	mismatches  []string
+	unset       []string // AWS API fields which are never set.
+	mapped      []string
+}
+
+func (r *report) problems() int {
+	return len(r.unmapped) + len(r.mismatches)
+}
+
+func (r *report) print(w io.Writer, verbose bool) {
+	if len(r.conversions) == 0 && len(r.skipped) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "%s (%s)\n", r.resource, typeName(r.model))
+	for _, c := range r.conversions {
+		if c.position != "" {
+			fmt.Fprintf(w, "  %s (%s)\n", c, c.position)
+		}
+	}
+	for _, s := range r.skipped {
+		fmt.Fprintf(w, "  not analyzed: %s\n", s)
+	}
+
+	printSection := func(title string, lines []string) {
+		if len(lines) == 0 {
+			return
+		}
+		fmt.Fprintf(w, "  %s:\n", title)
+		for _, line := range lines {
+			fmt.Fprintf(w, "    %s\n", line)
+		}
+	}
+
+	printSection("never mapped", r.unmapped)
+	printSection("type mismatches", r.mismatches)
+	printSection("never mapped, converted manually", r.manual)
+	printSection("ignored", r.ignored)
+	printSection("AWS API fields never set", r.unset)
+	if verbose {
+		printSection("mapped", r.mapped)
+	}
+}
+
+type packageAnalyzer struct {
+	pkg *packages.Package
+	// Struct fields referenced anywhere in the package other than in generated AutoFlex code.
+	referenced map[*types.Var]bool
+}
+
+func newPackageAnalyzer(pkg *packages.Package) *packageAnalyzer {
+	a := &packageAnalyzer{
+		pkg:        pkg,
+		referenced: make(map[*types.Var]bool),
+	}
+
+	for _, file := range pkg.Syntax {
+		if filepath.Base(pkg.Fset.File(file.Pos()).Name()) == "autoflex_gen.go" {
+			continue
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			if ident, ok := n.(*ast.Ident); ok {
+				if v, ok := pkg.TypesInfo.Uses[ident].(*types.Var); ok && v.IsField() {
+					a.referenced[v.Origin()] = true
+				}
+			}
+			return true
+		})
+	}
+
+	return a
+}
+
+func (a *packageAnalyzer) analyze() []*report {
+	var reports []*report
+
+	resources := a.resourceModels()
+	calls := a.flexCalls()
+
+	for _, resource := range resources {
+		r := &report{
+			resource: fmt.Sprintf("%s.%s", a.pkg.Name, resource.name),
+			model:    resource.model,
+		}
+
+		for _, call := range calls {
+			if call.model != resource.model {
+				continue
+			}
+			if call.skipped != "" {
+				r.skipped = append(r.skipped, fmt.Sprintf("%s (%s)", call.skipped, call.position))
+				continue
+			}
+			a.addConversion(r, call.matcher, call.expand, call.model, call.awsType, call.position)
+		}
+
+		a.summarize(r)
+		reports = append(reports, r)
+	}
+
+	return reports
+}
+
+// addConversion adds the field mappings of a conversion, and of any nested object conversions, to a report.
+// Nested objects are converted using the same options as their parent.
+func (a *packageAnalyzer) addConversion(r *report, matcher *common.AutoFlexFieldMatcher, expand bool, model, awsType *types.Named, position string) {
+	for _, c := range r.conversions {
+		if c.expand == expand && c.model == model && c.awsType == awsType {
+			if c.position == "" {
+				c.position = position
+			}
+			return
+		}
+	}
+
+	c := &conversion{
+		expand:   expand,
+		model:    model,
+		awsType:  awsType,
+		position: position,
+	}
+	if expand {
+		c.mappings = matcher.ExpandMappings(model, awsType)
+	} else {
+		c.mappings = matcher.FlattenMappings(awsType, model)
+	}
+	r.conversions = append(r.conversions, c)
+
+	for _, mapping := range c.mappings {
+		if mapping.Target == nil {
+			continue
+		}
+
+		modelField, awsField := mapping.Source, *mapping.Target
+		if !expand {
+			modelField, awsField = awsField, modelField
+		}
+
+		if nestedModel, nestedAWSType := nestedModelType(modelField.Var.Type()), nestedStructType(awsField.Var.Type()); nestedModel != nil && nestedAWSType != nil {
+			a.addConversion(r, matcher, expand, nestedModel, nestedAWSType, "")
+		}
+	}
+}
+
+func (a *packageAnalyzer) summarize(r *report) {
+	var models []*types.Named
+	mapped := make(map[*types.Var]bool)
+	set := make(map[*types.Var]bool)
+	var awsTypes []*types.Named
+
+	for _, c := range r.conversions {
+		if !slices.Contains(models, c.model) {
+			models = append(models, c.model)
+		}
+		if c.expand && !slices.Contains(awsTypes, c.awsType) {
+			awsTypes = append(awsTypes, c.awsType)
+		}
+
+		for _, mapping := range c.mappings {
+			if mapping.Ignored != "" {
+				if mapping.Ignored != common.AutoFlexIgnoredFieldName || !slices.Contains(common.AutoFlexIgnoredFieldNames, mapping.Source.Name()) {
+					r.ignored = append(r.ignored, fmt.Sprintf("%s.%s (%s, %s)", fieldOwner(c, true), mapping.Source.Name(), direction(c), mapping.Ignored))
+				}
+				continue
+			}
+			if mapping.Target == nil {
+				continue
+			}
+
+			modelField, awsField := mapping.Source, *mapping.Target
+			if !c.expand {
+				modelField, awsField = awsField, modelField
+			}
+			mapped[modelField.Var] = true
+			if c.expand {
+				set[awsField.Var] = true
+			}
+
+			r.mapped = append(r.mapped, fmt.Sprintf("%s.%s -> %s.%s", fieldOwner(c, true), mapping.Source.Name(), fieldOwner(c, false), mapping.Target.Name()))
+
+			if !compatible(modelField.Var.Type(), awsField.Var.Type()) {
+				r.mismatches = append(r.mismatches, fmt.Sprintf("%s.%s (%s) -> %s.%s (%s)",
+					fieldOwner(c, true), mapping.Source.Name(), types.TypeString(mapping.Source.Var.Type(), a.qualifier),
+					fieldOwner(c, false), mapping.Target.Name(), types.TypeString(mapping.Target.Var.Type(), a.qualifier)))
+			}
+		}
+	}
+
+	for _, model := range models {
+		for field := range common.StructFields(model) {
+			if !field.Var.Exported() || mapped[field.Var] || ignoredModelField(field) {
+				continue
+			}
+
+			name := fmt.Sprintf("%s.%s", typeName(model), field.Name())
+			if a.referenced[field.Var] {
+				r.manual = append(r.manual, name)
+			} else {
+				r.unmapped = append(r.unmapped, name)
+			}
+		}
+	}
+
+	for _, awsType := range awsTypes {
+		for field := range common.StructFields(awsType) {
+			if !field.Var.Exported() || set[field.Var] || a.referenced[field.Var] || slices.Contains(common.AutoFlexIgnoredFieldNames, field.Name()) {
+				continue
+			}
+
+			r.unset = append(r.unset, fmt.Sprintf("%s.%s", typeName(awsType), field.Name()))
+		}
+	}
+
+	for _, lines := range [][]string{r.unmapped, r.manual, r.ignored, r.mismatches, r.unset, r.mapped} {
+		slices.Sort(lines)
+	}
+	r.ignored = slices.Compact(r.ignored)
+	r.mismatches = slices.Compact(r.mismatches)
+	r.mapped = slices.Compact(r.mapped)
+}
+
+func (a *packageAnalyzer) qualifier(pkg *types.Package) string {
+	if pkg == a.pkg.Types {
+		return ""
+	}
+
+	return pkg.Name()
+}
+
+type resourceModel struct {
+	name  string
+	model *types.Named
+}
+
+// resourceModels returns the resource types in the package which embed framework.ResourceWithModel, and their models.
+func (a *packageAnalyzer) resourceModels() []resourceModel {
+	var resources []resourceModel
+
+	scope := a.pkg.Types.Scope()
+	for _, name := range scope.Names() {
+		typeName, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || typeName.IsAlias() {
+			continue
+		}
+		st, ok := typeName.Type().Underlying().(*types.Struct)
+		if !ok {
+			continue
+		}
+
+		for field := range st.Fields() {
+			if !field.Anonymous() {
+				continue
+			}
+			named, ok := types.Unalias(field.Type()).(*types.Named)
+			if !ok || !isNamed(named, frameworkPackagePath, "ResourceWithModel") || named.TypeArgs().Len() != 1 {
+				continue
+			}
+			if model := asNamedStruct(named.TypeArgs().At(0)); model != nil {
+				resources = append(resources, resourceModel{name: name, model: model})
+			}
+		}
+	}
+
+	return resources
+}
+
+type flexCall struct {
+	matcher  *common.AutoFlexFieldMatcher
+	expand   bool
+	model    *types.Named
+	awsType  *types.Named
+	position string
+	skipped  string
+}
+
+// flexCalls returns the calls to flex.Expand and flex.Flatten in the package.
+func (a *packageAnalyzer) flexCalls() []flexCall {
+	var calls []flexCall
+
+	for _, file := range a.pkg.Syntax {
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) < 3 {
+				return true
+			}
+
+			var ident *ast.Ident
+			switch fun := call.Fun.(type) {
+			case *ast.Ident:
+				ident = fun
+			case *ast.SelectorExpr:
+				ident = fun.Sel
+			default:
+				return true
+			}
+			fn, ok := a.pkg.TypesInfo.Uses[ident].(*types.Func)
+			if !ok || fn.Pkg() == nil || fn.Pkg().Path() != flexPackagePath {
+				return true
+			}
+
+			var c flexCall
+			switch fn.Name() {
+			case "Expand":
+				c.expand = true
+				c.model, c.awsType = asNamedStruct(a.pkg.TypesInfo.TypeOf(call.Args[1])), asNamedStruct(a.pkg.TypesInfo.TypeOf(call.Args[2]))
+			case "Flatten":
+				c.awsType, c.model = asNamedStruct(a.pkg.TypesInfo.TypeOf(call.Args[1])), asNamedStruct(a.pkg.TypesInfo.TypeOf(call.Args[2]))
+			default:
+				return true
+			}
+			if c.model == nil || c.awsType == nil {
+				return true
+			}
+
+			position := a.pkg.Fset.Position(call.Pos())
+			c.position = fmt.Sprintf("%s:%d", filepath.Base(position.Filename), position.Line)
+			if options, ok := a.options(call.Args[3:]); ok {
+				c.matcher = common.NewAutoFlexFieldMatcherWithOptions(options)
+			} else {
+				c.skipped = fmt.Sprintf("%s with non-constant options", fn.Name())
+			}
+
+			calls = append(calls, c)
+
+			return true
+		})
+	}
+
+	slices.SortStableFunc(calls, func(x, y flexCall) int {
+		return cmp.Compare(x.position, y.position)
+	})
+
+	return calls
+}
+
+// options returns the field matching options specified by the option arguments of a call to flex.Expand or flex.Flatten.
+// Only calls to the option functions with constant arguments are supported.
+func (a *packageAnalyzer) options(args []ast.Expr) (common.AutoFlexOptions, bool) {
+	options := common.DefaultAutoFlexOptions()
+
+	for _, arg := range args {
+		call, ok := arg.(*ast.CallExpr)
+		if !ok {
+			return options, false
+		}
+		var ident *ast.Ident
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			ident = fun
+		case *ast.SelectorExpr:
+			ident = fun.Sel
+		}
+		fn, ok := a.pkg.TypesInfo.Uses[ident].(*types.Func)
+		if !ok || fn.Pkg() == nil || fn.Pkg().Path() != flexPackagePath {
+			return options, false
+		}
+
+		switch fn.Name() {
+		case "WithFieldNamePrefix", "WithFieldNameSuffix", "WithIgnoredFieldNamesAppend":
+			v, ok := a.constantString(call.Args[0])
+			if !ok {
+				return options, false
+			}
+			switch fn.Name() {
+			case "WithFieldNamePrefix":
+				options.FieldNamePrefix = v
+			case "WithFieldNameSuffix":
+				options.FieldNameSuffix = v
+			default:
+				options.IgnoredFieldNames = append(options.IgnoredFieldNames, v)
+			}
+		case "WithIgnoredFieldNames":
+			lit, ok := call.Args[0].(*ast.CompositeLit)
+			if !ok {
+				return options, false
+			}
+			options.IgnoredFieldNames = nil
+			for _, elt := range lit.Elts {
+				v, ok := a.constantString(elt)
+				if !ok {
+					return options, false
+				}
+				options.IgnoredFieldNames = append(options.IgnoredFieldNames, v)
+			}
+		case "WithNoIgnoredFieldNames":
+			options.IgnoredFieldNames = nil
+		case "WithoutGeneratedCode":
+		default:
+			return options, false
+		}
+	}
+
+	return options, true
+}
+
+func (a *packageAnalyzer) constantString(expr ast.Expr) (string, bool) {
+	tv, ok := a.pkg.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+
+	return constant.StringVal(tv.Value), true
+}
+
+// ignoredModelField returns whether a model field is expected not to be mapped by AutoFlex.
+func ignoredModelField(field common.StructField) bool {
+	// Fields of embedded helper structs, e.g. framework.WithRegionModel.
+	if pkg := field.Var.Pkg(); pkg != nil && pkg.Path() == frameworkPackagePath {
+		return true
+	}
+	if name, _ := field.AutoFlexTag(); name == "-" {
+		return true
+	}
+	// Tags are handled by transparent tagging.
+	if slices.Contains(common.AutoFlexIgnoredFieldNames, field.Name()) || field.Name() == "TagsAll" {
+		return true
+	}
+	if isNamed(field.Var.Type(), timeoutsPackagePath, "Value") {
+		return true
+	}
+
+	return false
+}
+
+// nestedModelType returns the model type of a nested object field, if any.
+func nestedModelType(typ types.Type) *types.Named {
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok || named.TypeArgs().Len() != 1 {
+		return nil
+	}
+	for _, name := range []string{"ListNestedObjectValueOf", "ObjectValueOf", "SetNestedObjectValueOf"} {
+		if isNamed(named, fwtypesPackagePath, name) {
+			return asNamedStruct(named.TypeArgs().At(0))
+		}
+	}
+
+	return nil
+}
+
+// nestedStructType returns the struct type of an AWS API struct, pointer to struct, or slice of structs field, if any.
+func nestedStructType(typ types.Type) *types.Named {
+	if slice, ok := types.Unalias(typ).(*types.Slice); ok {
+		typ = slice.Elem()
+	}
+
+	return asNamedStruct(typ)
+}
+
+// asNamedStruct returns the named struct type, or pointer to named struct type, if any.
+func asNamedStruct(typ types.Type) *types.Named {
+	if typ == nil {
+		return nil
+	}
+	if ptr, ok := types.Unalias(typ).(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok {
+		return nil
+	}
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		return nil
+	}
+
+	return named
+}
+
+func isNamed(typ types.Type, pkgPath, name string) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Origin().Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == pkgPath && obj.Name() == name
+}
+
+// frameworkValueKind returns the kind of Plugin Framework value of a model field type, e.g. "String" or "List".
+// Custom value types are identified by the basetypes value type they embed.
+func frameworkValueKind(typ types.Type) string {
+	for range 8 {
+		named, ok := types.Unalias(typ).(*types.Named)
+		if !ok {
+			return ""
+		}
+
+		if obj := named.Origin().Obj(); obj.Pkg() != nil && obj.Pkg().Path() == basetypesPackagePath {
+			return strings.TrimSuffix(obj.Name(), "Value")
+		}
+
+		st, ok := named.Underlying().(*types.Struct)
+		if !ok || st.NumFields() == 0 || !st.Field(0).Anonymous() {
+			return ""
+		}
+		typ = st.Field(0).Type()
+	}
+
+	return ""
+}
+
+// awsValueKind returns the kind of an AWS API struct field type, e.g. "string" or "slice".
+func awsValueKind(typ types.Type) string {
+	if ptr, ok := types.Unalias(typ).(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	if isNamed(typ, "time", "Time") {
+		return "timestamp"
+	}
+
+	switch typ := typ.Underlying().(type) {
+	case *types.Basic:
+		switch info := typ.Info(); {
+		case info&types.IsString != 0:
+			return "string"
+		case info&types.IsBoolean != 0:
+			return "bool"
+		case info&types.IsInteger != 0:
+			return "int"
+		case info&types.IsFloat != 0:
+			return "float"
+		}
+	case *types.Map:
+		return "map"
+	case *types.Slice:
+		return "slice"
+	case *types.Struct:
+		return "struct"
+	}
+
+	return ""
+}
+
+// compatible returns whether AutoFlex can convert between a model field type and an AWS API field type.
+// Only known incompatible combinations are reported as incompatible.
+func compatible(modelType, awsType types.Type) bool {
+	// Custom conversions.
+	methods := types.NewMethodSet(types.NewPointer(modelType))
+	for _, name := range []string{"Expand", "ExpandTo", "Flatten"} {
+		if methods.Lookup(nil, name) != nil {
+			return true
+		}
+	}
+
+	modelKind, awsKind := frameworkValueKind(modelType), awsValueKind(awsType)
+	if modelKind == "" || awsKind == "" {
+		return true
+	}
+
+	var kinds []string
+	switch modelKind {
+	case "String":
+		kinds = []string{"string", "timestamp"}
+	case "Bool":
+		kinds = []string{"bool"}
+	case "Int32", "Int64":
+		kinds = []string{"int"}
+	case "Float32", "Float64", "Number":
+		kinds = []string{"float", "int"}
+	case "List", "Set":
+		kinds = []string{"map", "slice", "struct"}
+	case "Map":
+		kinds = []string{"map"}
+	case "Object":
+		kinds = []string{"struct"}
+	default:
+		return true
+	}
+
+	return slices.Contains(kinds, awsKind)
+}
+
+func direction(c *conversion) string {
+	if c.expand {
+		return "expand"
+	}
+
+	return "flatten"
+}
+
+// fieldOwner returns the name of the source or target type of a conversion.
+func fieldOwner(c *conversion, source bool) string {
+	if c.expand == source {
+		return typeName(c.model)
+	}
+
+	return typeName(c.awsType)
+}
+
+func typeName(named *types.Named) string {
+	obj := named.Obj()
+	if obj.Pkg() == nil {
+		return obj.Name()
+	}
+	if strings.HasPrefix(obj.Pkg().Path(), "github.com/aws/aws-sdk-go-v2/") {
+		return obj.Pkg().Name() + "." + obj.Name()
+	}
+
+	return obj.Name()
+}
@@ -0,0 +1,307 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"go/ast"
+	"go/types"
+	"iter"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/gertd/go-pluralize"
+)
+
+// Static equivalents of the reflective AutoFlex implementation's field matching rules, for use by code generators and linters.
+// These must be kept in sync with internal/framework/flex.
+
+var (
+	// AutoFlexIgnoredFieldNames are the names of fields which are ignored by AutoFlex by default.
+	AutoFlexIgnoredFieldNames = []string{"Tags"}
+)
+
+const (
+	autoFlexMapBlockKeyFieldName = "MapBlockKey"
+
+	// AutoFlexIgnoredFieldName is the reason reported for source fields with an ignored field name.
+	AutoFlexIgnoredFieldName = "ignored field name"
+)
+
+// StructField is a field of a struct type.
+type StructField struct {
+	Var *types.Var
+	Tag string
+}
+
+func (f StructField) Name() string {
+	return f.Var.Name()
+}
+
+// AutoFlexTag returns the name override and options from the field's `autoflex` struct tag.
+func (f StructField) AutoFlexTag() (string, AutoFlexTagOptions) {
+	name, opts, _ := strings.Cut(reflect.StructTag(f.Tag).Get("autoflex"), ",")
+
+	return name, AutoFlexTagOptions(opts)
+}
+
+// AutoFlexTagOptions are the comma-separated options of an `autoflex` struct tag.
+type AutoFlexTagOptions string
+
+func (o AutoFlexTagOptions) Contains(option string) bool {
+	return slices.Contains(strings.Split(string(o), ","), option)
+}
+
+func (o AutoFlexTagOptions) HasPrefix(prefix string) bool {
+	return slices.ContainsFunc(strings.Split(string(o), ","), func(s string) bool {
+		return strings.HasPrefix(s, prefix)
+	})
+}
+
+// StructFields returns an iterator over the fields of a named struct type, including those of embedded structs.
+func StructFields(named *types.Named) iter.Seq[StructField] {
+	return func(yield func(StructField) bool) {
+		if st, ok := named.Underlying().(*types.Struct); ok {
+			structFields(st, yield)
+		}
+	}
+}
+
+func structFields(st *types.Struct, yield func(StructField) bool) bool {
+	for i := range st.NumFields() {
+		field := st.Field(i)
+
+		if field.Anonymous() {
+			if embedded, ok := field.Type().Underlying().(*types.Struct); ok {
+				if !structFields(embedded, yield) {
+					return false
+				}
+			}
+			continue
+		}
+
+		if !yield(StructField{Var: field, Tag: st.Tag(i)}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FieldByName returns the named field of a named struct type, including promoted fields.
+func FieldByName(named *types.Named, name string) (StructField, bool) {
+	if !ast.IsExported(name) {
+		return StructField{}, false
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(named, false, nil, name)
+	field, ok := obj.(*types.Var)
+	if !ok || !field.IsField() {
+		return StructField{}, false
+	}
+
+	for f := range StructFields(named) {
+		if f.Var == field {
+			return f, true
+		}
+	}
+
+	return StructField{}, false
+}
+
+// IsXMLWrapper returns whether the specified type is an AWS API XML wrapper struct,
+// i.e. a struct with a slice field and a *int32 Quantity field.
+func IsXMLWrapper(typ types.Type) bool {
+	st, ok := typ.Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+
+	var hasSlice, hasQuantity bool
+	for field := range st.Fields() {
+		if _, ok := field.Type().Underlying().(*types.Slice); ok {
+			hasSlice = true
+		}
+		if ptr, ok := types.Unalias(field.Type()).(*types.Pointer); ok && field.Name() == "Quantity" {
+			if basic, ok := types.Unalias(ptr.Elem()).(*types.Basic); ok && basic.Kind() == types.Int32 {
+				hasQuantity = true
+			}
+		}
+	}
+
+	return hasSlice && hasQuantity
+}
+
+// AutoFlexFieldMapping describes how AutoFlex handles a single source struct field.
+type AutoFlexFieldMapping struct {
+	Source StructField
+	// Target is the matching target field, if any.
+	Target *StructField
+	// Ignored is the reason that the source field is ignored, if it is.
+	Ignored string
+}
+
+// AutoFlexOptions are the AutoFlex options which affect field matching.
+type AutoFlexOptions struct {
+	IgnoredFieldNames []string
+	FieldNamePrefix   string
+	FieldNameSuffix   string
+}
+
+// DefaultAutoFlexOptions returns the options used by AutoFlex when none are specified.
+func DefaultAutoFlexOptions() AutoFlexOptions {
+	return AutoFlexOptions{
+		IgnoredFieldNames: slices.Clone(AutoFlexIgnoredFieldNames),
+	}
+}
+
+// AutoFlexFieldMatcher matches struct fields as AutoFlex does.
+type AutoFlexFieldMatcher struct {
+	options   AutoFlexOptions
+	pluralize *pluralize.Client
+}
+
+// NewAutoFlexFieldMatcher returns a matcher using the default AutoFlex options.
+func NewAutoFlexFieldMatcher() *AutoFlexFieldMatcher {
+	return NewAutoFlexFieldMatcherWithOptions(DefaultAutoFlexOptions())
+}
+
+func NewAutoFlexFieldMatcherWithOptions(options AutoFlexOptions) *AutoFlexFieldMatcher {
+	return &AutoFlexFieldMatcher{
+		options:   options,
+		pluralize: pluralize.NewClient(),
+	}
+}
+
+// ExpandMappings returns the field mappings used when expanding a model into an AWS API struct.
+func (m *AutoFlexFieldMatcher) ExpandMappings(model, awsType *types.Named) []AutoFlexFieldMapping {
+	var mappings []AutoFlexFieldMapping
+
+	for from := range StructFields(model) {
+		if !from.Var.Exported() {
+			continue
+		}
+
+		mapping := AutoFlexFieldMapping{Source: from}
+		nameOverride, opts := from.AutoFlexTag()
+		switch {
+		case slices.Contains(m.options.IgnoredFieldNames, from.Name()):
+			mapping.Ignored = AutoFlexIgnoredFieldName
+		case from.Name() == autoFlexMapBlockKeyFieldName:
+			mapping.Ignored = "map block key"
+		case nameOverride == "-":
+			mapping.Ignored = `autoflex:"-"`
+		case opts.Contains("noexpand"):
+			mapping.Ignored = "noexpand"
+		default:
+			if to, ok := m.FindField(from.Name(), model, awsType); ok && to.Var.Exported() {
+				mapping.Target = &to
+			}
+		}
+
+		mappings = append(mappings, mapping)
+	}
+
+	return mappings
+}
+
+// FlattenMappings returns the field mappings used when flattening an AWS API struct into a model.
+func (m *AutoFlexFieldMatcher) FlattenMappings(awsType, model *types.Named) []AutoFlexFieldMapping {
+	var mappings []AutoFlexFieldMapping
+
+	for from := range StructFields(awsType) {
+		if !from.Var.Exported() {
+			continue
+		}
+
+		mapping := AutoFlexFieldMapping{Source: from}
+		if slices.Contains(m.options.IgnoredFieldNames, from.Name()) {
+			mapping.Ignored = AutoFlexIgnoredFieldName
+		} else if to, ok := m.FindField(from.Name(), awsType, model); ok && to.Var.Exported() {
+			nameOverride, opts := to.AutoFlexTag()
+			switch {
+			case nameOverride == "-":
+				mapping.Ignored = `autoflex:"-"`
+			case opts.Contains("noflatten"):
+				mapping.Ignored = "noflatten"
+			default:
+				mapping.Target = &to
+			}
+		}
+
+		mappings = append(mappings, mapping)
+	}
+
+	return mappings
+}
+
+// FindField returns the field in the target struct corresponding to the named field in the source struct.
+func (m *AutoFlexFieldMatcher) FindField(fromName string, from, to *types.Named) (StructField, bool) {
+	return m.findField(fromName, from, to, true)
+}
+
+func (m *AutoFlexFieldMatcher) findField(fromName string, from, to *types.Named, affixes bool) (StructField, bool) {
+	// First precedence is exact match (case sensitive).
+	if field, ok := FieldByName(to, fromName); ok {
+		return field, true
+	}
+
+	// Second precedence is exact match (case insensitive).
+	for field := range StructFields(to) {
+		toName := field.Name()
+		if !field.Var.Exported() || slices.Contains(m.options.IgnoredFieldNames, toName) {
+			continue
+		}
+		if _, ok := FieldByName(to, toName); ok && strings.EqualFold(fromName, toName) && !fieldExists(from, toName) {
+			return field, true
+		}
+	}
+
+	// Third precedence is singular/plural.
+	toName := m.pluralize.Plural(fromName)
+	if m.pluralize.IsSingular(fromName) && !fieldExists(from, toName) {
+		if field, ok := FieldByName(to, toName); ok {
+			return field, true
+		}
+	}
+
+	toName = m.pluralize.Singular(fromName)
+	if m.pluralize.IsPlural(fromName) && !fieldExists(from, toName) {
+		if field, ok := FieldByName(to, toName); ok {
+			return field, true
+		}
+	}
+
+	if !affixes {
+		return StructField{}, false
+	}
+
+	// Fourth precedence is using field name prefix.
+	if v := strings.ReplaceAll(m.options.FieldNamePrefix, " ", ""); v != "" {
+		name := v + fromName
+		if trimmed, ok := strings.CutPrefix(fromName, v); ok {
+			name = trimmed
+		}
+		if field, ok := m.findField(name, from, to, false); ok {
+			return field, true
+		}
+	}
+
+	// Fifth precedence is using field name suffix.
+	if v := strings.ReplaceAll(m.options.FieldNameSuffix, " ", ""); v != "" {
+		name := fromName + v
+		if trimmed, ok := strings.CutSuffix(fromName, v); ok {
+			name = trimmed
+		}
+		return m.findField(name, from, to, false)
+	}
+
+	return StructField{}, false
+}
+
+func fieldExists(named *types.Named, name string) bool {
+	_, ok := FieldByName(named, name)
+
+	return ok
+}
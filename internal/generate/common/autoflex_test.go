@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+)
+
+const autoFlexTestSource = `
+package test
+
+type embedded struct {
+	Region string
+}
+
+type model struct {
+	embedded
+	Name        string
+	Description string ` + "`autoflex:\",omitempty\"`" + `
+	Values      []string
+	Policy      string ` + "`autoflex:\"-\"`" + `
+	State       string ` + "`autoflex:\",noexpand\"`" + `
+	Arn         string
+	Tags        map[string]string
+	Missing     string
+	unexported  string
+}
+
+type awsStruct struct {
+	Region      *string
+	Name        *string
+	Description *string
+	Value       []string
+	Policy      *string
+	State       *string
+	ARN         *string
+	Tags        map[string]string
+	Extra       *string
+}
+
+type xmlWrapper struct {
+	Items    []string
+	Quantity *int32
+}
+`
+
+func autoFlexTestTypes(t *testing.T) (*types.Named, *types.Named, *types.Package) {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "test.go", autoFlexTestSource, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := new(types.Config).Check("test", fset, []*ast.File{file}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pkg.Scope().Lookup("model").Type().(*types.Named), pkg.Scope().Lookup("awsStruct").Type().(*types.Named), pkg
+}
+
+func TestAutoFlexExpandMappings(t *testing.T) {
+	t.Parallel()
+
+	model, awsStruct, _ := autoFlexTestTypes(t)
+	got := make(map[string]string)
+	for _, mapping := range NewAutoFlexFieldMatcher().ExpandMappings(model, awsStruct) {
+		switch {
+		case mapping.Ignored != "":
+			got[mapping.Source.Name()] = "ignored: " + mapping.Ignored
+		case mapping.Target != nil:
+			got[mapping.Source.Name()] = mapping.Target.Name()
+		default:
+			got[mapping.Source.Name()] = ""
+		}
+	}
+
+	want := map[string]string{
+		"Region":      "Region",
+		"Name":        "Name",
+		"Description": "Description",
+		"Values":      "Value",
+		"Policy":      `ignored: autoflex:"-"`,
+		"State":       "ignored: noexpand",
+		"Arn":         "ARN",
+		"Tags":        "ignored: ignored field name",
+		"Missing":     "",
+	}
+
+	if len(got) != len(want) {
+		t.Errorf("got %d mappings, want %d", len(got), len(want))
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("mapping for %s = %q, want %q", k, got[k], v)
+		}
+	}
+}
+
+func TestAutoFlexFlattenMappings(t *testing.T) {
+	t.Parallel()
+
+	model, awsStruct, _ := autoFlexTestTypes(t)
+	got := make(map[string]string)
+	for _, mapping := range NewAutoFlexFieldMatcher().FlattenMappings(awsStruct, model) {
+		switch {
+		case mapping.Ignored != "":
+			got[mapping.Source.Name()] = "ignored: " + mapping.Ignored
+		case mapping.Target != nil:
+			got[mapping.Source.Name()] = mapping.Target.Name()
+		default:
+			got[mapping.Source.Name()] = ""
+		}
+	}
+
+	want := map[string]string{
+		"Region":      "Region",
+		"Name":        "Name",
+		"Description": "Description",
+		"Value":       "Values",
+		"Policy":      `ignored: autoflex:"-"`,
+		"State":       "State",
+		"ARN":         "Arn",
+		"Tags":        "ignored: ignored field name",
+		"Extra":       "",
+	}
+
+	if len(got) != len(want) {
+		t.Errorf("got %d mappings, want %d", len(got), len(want))
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("mapping for %s = %q, want %q", k, got[k], v)
+		}
+	}
+}
+
+func TestAutoFlexTagOptions(t *testing.T) {
+	t.Parallel()
+
+	model, _, _ := autoFlexTestTypes(t)
+
+	field, ok := FieldByName(model, "Description")
+	if !ok {
+		t.Fatal("field Description not found")
+	}
+	name, opts := field.AutoFlexTag()
+	if name != "" {
+		t.Errorf("name override = %q, want %q", name, "")
+	}
+	if !opts.Contains("omitempty") {
+		t.Errorf("options %q do not contain omitempty", opts)
+	}
+	if opts.Contains("legacy") {
+		t.Errorf("options %q contain legacy", opts)
+	}
+
+	if _, ok := FieldByName(model, "unexported"); ok {
+		t.Error("unexported field found")
+	}
+}
+
+func TestIsXMLWrapper(t *testing.T) {
+	t.Parallel()
+
+	model, awsStruct, pkg := autoFlexTestTypes(t)
+
+	if IsXMLWrapper(model) {
+		t.Error("model is an XML wrapper")
+	}
+	if IsXMLWrapper(awsStruct) {
+		t.Error("awsStruct is an XML wrapper")
+	}
+	if !IsXMLWrapper(pkg.Scope().Lookup("xmlWrapper").Type()) {
+		t.Error("xmlWrapper is not an XML wrapper")
+	}
+}
+
+func TestAutoFlexFieldMatcherWithOptions(t *testing.T) {
+	t.Parallel()
+
+	model, awsStruct, _ := autoFlexTestTypes(t)
+
+	matcher := NewAutoFlexFieldMatcherWithOptions(AutoFlexOptions{
+		FieldNamePrefix: "Ext",
+		FieldNameSuffix: "ing",
+	})
+
+	if _, ok := matcher.FindField("Tags", model, awsStruct); !ok {
+		t.Error("field Tags not found with no ignored field names")
+	}
+	if field, ok := matcher.FindField("Extra", model, awsStruct); !ok || field.Name() != "Extra" {
+		t.Errorf("field Extra: got %v, %t", field.Var, ok)
+	}
+	if field, ok := matcher.FindField("ra", model, awsStruct); !ok || field.Name() != "Extra" {
+		t.Errorf("field ra (prefix): got %v, %t", field.Var, ok)
+	}
+	if field, ok := matcher.FindField("Missing", model, awsStruct); ok {
+		t.Errorf("field Missing: got %v", field.Var)
+	}
+	if field, ok := matcher.FindField("Nameing", model, awsStruct); !ok || field.Name() != "Name" {
+		t.Errorf("field Nameing (suffix): got %v, %t", field.Var, ok)
+	}
+}
@@ -460,6 +460,11 @@ func (d ResourceDatum) HasRegionAttribute() bool {
 	return !d.IsGlobal || d.RegionOverrideDeprecated
 }
 
+// HasAssumeRoleIdentityAttribute returns whether the resource's identity includes the injected "assume_role" attribute.
+func (d ResourceDatum) HasAssumeRoleIdentityAttribute() bool {
+	return d.HasRegionAttribute()
+}
+
 type commonConfig struct {
 	AdditionalTfVars      []string
 	WithRName             bool
@@ -0,0 +1,314 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	aschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	erschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/datasourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func validateInContextAssumeRole(ctx context.Context, c awsClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := c.ValidateInContextAssumeRole(ctx); err != nil {
+		diags.AddAttributeError(path.Root(names.AttrAssumeRole), "Invalid IAM Role Value", err.Error())
+	}
+
+	return diags
+}
+
+type dataSourceInjectAssumeRoleAttributeInterceptor struct{}
+
+func (r dataSourceInjectAssumeRoleAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[datasource.SchemaRequest, datasource.SchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Attributes[names.AttrAssumeRole]; !ok {
+			// Inject a top-level "assume_role" attribute.
+			response.Schema.Attributes[names.AttrAssumeRole] = datasourceattribute.AssumeRole()
+		}
+	}
+}
+
+// dataSourceInjectAssumeRoleAttribute injects a top-level "assume_role" attribute into a data source's schema.
+func dataSourceInjectAssumeRoleAttribute() dataSourceSchemaInterceptor {
+	return &dataSourceInjectAssumeRoleAttributeInterceptor{}
+}
+
+type dataSourceValidateAssumeRoleInterceptor struct{}
+
+func (r dataSourceValidateAssumeRoleInterceptor) read(ctx context.Context, opts interceptorOptions[datasource.ReadRequest, datasource.ReadResponse]) {
+	c := opts.c
+
+	switch when := opts.when; when {
+	case Before:
+		// As data sources have no ModifyPlan functionality we validate the per-resource IAM role override value before R.
+		opts.response.Diagnostics.Append(validateInContextAssumeRole(ctx, c)...)
+		if opts.response.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// dataSourceValidateAssumeRole validates that the value of the top-level `assume_role` attribute is an IAM role in the configured AWS partition.
+func dataSourceValidateAssumeRole() dataSourceCRUDInterceptor {
+	return &dataSourceValidateAssumeRoleInterceptor{}
+}
+
+type resourceInjectAssumeRoleAttributeInterceptor struct{}
+
+func (r resourceInjectAssumeRoleAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[resource.SchemaRequest, resource.SchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Attributes[names.AttrAssumeRole]; !ok {
+			// Inject a top-level "assume_role" attribute.
+			response.Schema.Attributes[names.AttrAssumeRole] = resourceattribute.AssumeRole()
+		}
+	}
+}
+
+// resourceInjectAssumeRoleAttribute injects a top-level "assume_role" attribute into a resource's schema.
+func resourceInjectAssumeRoleAttribute() resourceSchemaInterceptor {
+	return &resourceInjectAssumeRoleAttributeInterceptor{}
+}
+
+type resourceValidateAssumeRoleInterceptor struct{}
+
+func (r resourceValidateAssumeRoleInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	switch when := opts.when; when {
+	case Before:
+		opts.response.Diagnostics.Append(validateInContextAssumeRole(ctx, c)...)
+		if opts.response.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// resourceValidateAssumeRole validates that the value of the top-level `assume_role` attribute is an IAM role in the configured AWS partition.
+func resourceValidateAssumeRole() resourceModifyPlanInterceptor {
+	return &resourceValidateAssumeRoleInterceptor{}
+}
+
+type resourceForceNewIfAssumeRoleAccountChangesInterceptor struct{}
+
+func (r resourceForceNewIfAssumeRoleAccountChangesInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		// If the entire plan is null, the resource is planned for destruction.
+		if request.Plan.Raw.IsNull() {
+			return
+		}
+
+		// If the entire state is null, the resource is new.
+		if request.State.Raw.IsNull() {
+			return
+		}
+
+		var planAssumeRole types.String
+		opts.response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrAssumeRole), &planAssumeRole)...)
+		if opts.response.Diagnostics.HasError() {
+			return
+		}
+
+		var stateAssumeRole types.String
+		opts.response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrAssumeRole), &stateAssumeRole)...)
+		if opts.response.Diagnostics.HasError() {
+			return
+		}
+
+		if planAssumeRole.Equal(stateAssumeRole) {
+			return
+		}
+
+		// Only a change in the effective AWS account requires replacement.
+		if planAssumeRole.IsUnknown() || c.AccountIDForAssumeRole(planAssumeRole.ValueString()) != c.AccountIDForAssumeRole(stateAssumeRole.ValueString()) {
+			response.RequiresReplace = response.RequiresReplace.Append(path.Root(names.AttrAssumeRole))
+		}
+	}
+}
+
+// resourceForceNewIfAssumeRoleAccountChanges forces resource replacement if the value of the top-level `assume_role` attribute changes the effective AWS account.
+func resourceForceNewIfAssumeRoleAccountChanges() resourceModifyPlanInterceptor {
+	return &resourceForceNewIfAssumeRoleAccountChangesInterceptor{}
+}
+
+type resourceImportAssumeRoleInterceptor struct{}
+
+func (r resourceImportAssumeRoleInterceptor) importState(ctx context.Context, opts interceptorOptions[resource.ImportStateRequest, resource.ImportStateResponse]) {
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		var roleARN string
+
+		// Import ID optionally ends with "@<role-arn>".
+		if matches := regexache.MustCompile(`^(.+)@(` + inttypes.IAMRoleARNPatternNoAnchors + `)$`).FindStringSubmatch(request.ID); len(matches) == 3 {
+			request.ID = matches[1]
+			roleARN = matches[2]
+		} else if identity := request.Identity; identity != nil && !identity.Raw.IsNull() {
+			// Resource identity optionally includes "assume_role".
+			var v types.String
+			opts.response.Diagnostics.Append(identity.GetAttribute(ctx, path.Root(names.AttrAssumeRole), &v)...)
+			if opts.response.Diagnostics.HasError() {
+				return
+			}
+
+			if v.ValueString() != "" && !regexache.MustCompile(`^`+inttypes.IAMRoleARNPatternNoAnchors+`$`).MatchString(v.ValueString()) {
+				opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrAssumeRole), "Invalid IAM Role Value", fmt.Sprintf("identity attribute %q must be an IAM role ARN, got %q", names.AttrAssumeRole, v.ValueString()))
+				return
+			}
+
+			roleARN = v.ValueString()
+		}
+
+		if roleARN == "" {
+			return
+		}
+
+		opts.response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrAssumeRole), roleARN)...)
+		if opts.response.Diagnostics.HasError() {
+			return
+		}
+
+		// The import ID or identity was not available when the context was bootstrapped.
+		if inContext, ok := conns.FromContext(ctx); ok {
+			inContext.SetOverrideAssumeRole(roleARN)
+		}
+	}
+}
+
+// resourceImportAssumeRole sets the value of the top-level `assume_role` attribute during import.
+func resourceImportAssumeRole() resourceImportStateInterceptor {
+	return &resourceImportAssumeRoleInterceptor{}
+}
+
+type ephemeralResourceInjectAssumeRoleAttributeInterceptor struct{}
+
+func (r ephemeralResourceInjectAssumeRoleAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[ephemeral.SchemaRequest, ephemeral.SchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Attributes[names.AttrAssumeRole]; !ok {
+			// Inject a top-level "assume_role" attribute.
+			response.Schema.Attributes[names.AttrAssumeRole] = erschema.StringAttribute{
+				Optional:    true,
+				Description: names.ResourceTopLevelAssumeRoleAttributeDescription,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^`+inttypes.IAMRoleARNPatternNoAnchors+`$`), "must be an IAM role ARN"),
+				},
+			}
+		}
+	}
+}
+
+// ephemeralResourceInjectAssumeRoleAttribute injects a top-level "assume_role" attribute into an ephemeral resource's schema.
+func ephemeralResourceInjectAssumeRoleAttribute() ephemeralResourceSchemaInterceptor {
+	return &ephemeralResourceInjectAssumeRoleAttributeInterceptor{}
+}
+
+type ephemeralResourceValidateAssumeRoleInterceptor struct {
+	ephemeralResourceNoOpORCInterceptor
+}
+
+func (r ephemeralResourceValidateAssumeRoleInterceptor) open(ctx context.Context, opts interceptorOptions[ephemeral.OpenRequest, ephemeral.OpenResponse]) {
+	c := opts.c
+
+	switch when := opts.when; when {
+	case Before:
+		// As ephemeral resources have no ModifyPlan functionality we validate the per-resource IAM role override value here.
+		opts.response.Diagnostics.Append(validateInContextAssumeRole(ctx, c)...)
+		if opts.response.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// ephemeralResourceValidateAssumeRole validates that the value of the top-level `assume_role` attribute is an IAM role in the configured AWS partition.
+func ephemeralResourceValidateAssumeRole() ephemeralResourceORCInterceptor {
+	return &ephemeralResourceValidateAssumeRoleInterceptor{}
+}
+
+type actionInjectAssumeRoleAttributeInterceptor struct{}
+
+func (a actionInjectAssumeRoleAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[action.SchemaRequest, action.SchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, exists := response.Schema.Attributes[names.AttrAssumeRole]; !exists {
+			// Inject a top-level "assume_role" attribute.
+			if response.Schema.Attributes == nil {
+				response.Schema.Attributes = make(map[string]aschema.Attribute)
+			}
+			response.Schema.Attributes[names.AttrAssumeRole] = aschema.StringAttribute{
+				Optional:    true,
+				Description: names.ActionTopLevelAssumeRoleAttributeDescription,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^`+inttypes.IAMRoleARNPatternNoAnchors+`$`), "must be an IAM role ARN"),
+				},
+			}
+		}
+	}
+}
+
+// actionInjectAssumeRoleAttribute injects a top-level "assume_role" attribute into an action's schema.
+func actionInjectAssumeRoleAttribute() actionSchemaInterceptor {
+	return &actionInjectAssumeRoleAttributeInterceptor{}
+}
+
+type actionValidateAssumeRoleInterceptor struct{}
+
+func (a actionValidateAssumeRoleInterceptor) invoke(ctx context.Context, opts interceptorOptions[action.InvokeRequest, action.InvokeResponse]) {
+	c := opts.c
+
+	switch when := opts.when; when {
+	case Before:
+		opts.response.Diagnostics.Append(validateInContextAssumeRole(ctx, c)...)
+	}
+}
+
+// actionValidateAssumeRole validates that the value of the top-level `assume_role` attribute is an IAM role in the configured AWS partition.
+func actionValidateAssumeRole() actionInvokeInterceptor {
+	return &actionValidateAssumeRoleInterceptor{}
+}
+
+type listResourceInjectAssumeRoleAttributeInterceptor struct{}
+
+func (r listResourceInjectAssumeRoleAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[list.ListResourceSchemaRequest, list.ListResourceSchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Attributes[names.AttrAssumeRole]; !ok {
+			if response.Schema.Attributes == nil {
+				// Initialize the attributes map if a custom ConfigSchema method has omitted it
+				response.Schema.Attributes = map[string]listschema.Attribute{}
+			}
+			// Inject a top-level "assume_role" attribute.
+			response.Schema.Attributes[names.AttrAssumeRole] = listresourceattribute.AssumeRole()
+		}
+	}
+}
+
+// listResourceInjectAssumeRoleAttribute injects an "assume_role" attribute into a resource's List schema.
+func listResourceInjectAssumeRoleAttribute() listResourceSchemaInterceptor {
+	return &listResourceInjectAssumeRoleAttributeInterceptor{}
+}
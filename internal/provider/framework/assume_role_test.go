@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/identity"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestResourceForceNewIfAssumeRoleAccountChangesInterceptor_ModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	const (
+		name              = "example"
+		roleSameAccount1  = "arn:aws:iam::123456789012:role/one"   //lintignore:AWSAT005
+		roleSameAccount2  = "arn:aws:iam::123456789012:role/two"   //lintignore:AWSAT005
+		roleOtherAccount  = "arn:aws:iam::210987654321:role/other" //lintignore:AWSAT005
+		providerAccountID = "123456789012"
+	)
+
+	ctx := context.Background()
+	client := mockClient{accountID: providerAccountID}
+	icpt := resourceForceNewIfAssumeRoleAccountChangesInterceptor{}
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName:       schema.StringAttribute{Required: true},
+			names.AttrAssumeRole: resourceattribute.AssumeRole(),
+		},
+	}
+
+	tests := map[string]struct {
+		state         tfsdk.State
+		plan          tfsdk.Plan
+		expectReplace bool
+	}{
+		"new resource": {
+			state:         nullStateFromSchema(ctx, s),
+			plan:          planFromSchema(ctx, s, map[string]string{names.AttrName: name, names.AttrAssumeRole: roleOtherAccount}),
+			expectReplace: false,
+		},
+		"no change": {
+			state:         stateFromSchema(ctx, s, map[string]string{names.AttrName: name, names.AttrAssumeRole: roleSameAccount1}),
+			plan:          planFromSchema(ctx, s, map[string]string{names.AttrName: name, names.AttrAssumeRole: roleSameAccount1}),
+			expectReplace: false,
+		},
+		"role changes within account": {
+			state:         stateFromSchema(ctx, s, map[string]string{names.AttrName: name, names.AttrAssumeRole: roleSameAccount1}),
+			plan:          planFromSchema(ctx, s, map[string]string{names.AttrName: name, names.AttrAssumeRole: roleSameAccount2}),
+			expectReplace: false,
+		},
+		"role added in provider account": {
+			state:         stateFromSchema(ctx, s, map[string]string{names.AttrName: name}),
+			plan:          planFromSchema(ctx, s, map[string]string{names.AttrName: name, names.AttrAssumeRole: roleSameAccount1}),
+			expectReplace: false,
+		},
+		"role added in other account": {
+			state:         stateFromSchema(ctx, s, map[string]string{names.AttrName: name}),
+			plan:          planFromSchema(ctx, s, map[string]string{names.AttrName: name, names.AttrAssumeRole: roleOtherAccount}),
+			expectReplace: true,
+		},
+		"role changes account": {
+			state:         stateFromSchema(ctx, s, map[string]string{names.AttrName: name, names.AttrAssumeRole: roleOtherAccount}),
+			plan:          planFromSchema(ctx, s, map[string]string{names.AttrName: name, names.AttrAssumeRole: roleSameAccount1}),
+			expectReplace: true,
+		},
+		"role unknown": {
+			state: stateFromSchema(ctx, s, map[string]string{names.AttrName: name, names.AttrAssumeRole: roleSameAccount1}),
+			plan: tfsdk.Plan{
+				Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+					names.AttrName:       tftypes.NewValue(tftypes.String, name),
+					names.AttrAssumeRole: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+				Schema: s,
+			},
+			expectReplace: true,
+		},
+	}
+
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ModifyPlanRequest{State: tc.state, Plan: tc.plan}
+			resp := resource.ModifyPlanResponse{Plan: tc.plan}
+
+			icpt.modifyPlan(ctx, interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c:        client,
+				request:  &req,
+				response: &resp,
+				when:     Before,
+			})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diags: %s", resp.Diagnostics)
+			}
+
+			if got, want := resp.RequiresReplace.Contains(path.Root(names.AttrAssumeRole)), tc.expectReplace; got != want {
+				t.Errorf("RequiresReplace contains %q = %t, want %t", names.AttrAssumeRole, got, want)
+			}
+		})
+	}
+}
+
+func TestResourceImportAssumeRoleInterceptor_ImportState(t *testing.T) {
+	t.Parallel()
+
+	const (
+		name    = "example"
+		roleARN = "arn:aws:iam::123456789012:role/example" //lintignore:AWSAT005
+	)
+
+	ctx := context.Background()
+	client := mockClient{accountID: "123456789012"}
+	icpt := resourceImportAssumeRoleInterceptor{}
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName:       schema.StringAttribute{Required: true},
+			names.AttrAssumeRole: resourceattribute.AssumeRole(),
+		},
+	}
+	identitySchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			names.AttrName:       identityschema.StringAttribute{RequiredForImport: true},
+			names.AttrAssumeRole: identity.AssumeRoleAttribute(),
+		},
+	}
+	identityFromValues := func(values map[string]string) *tfsdk.ResourceIdentity {
+		val := make(map[string]tftypes.Value)
+		for name := range identitySchema.Attributes {
+			if v, ok := values[name]; ok {
+				val[name] = tftypes.NewValue(tftypes.String, v)
+			} else {
+				val[name] = tftypes.NewValue(tftypes.String, nil)
+			}
+		}
+		return &tfsdk.ResourceIdentity{
+			Raw:    tftypes.NewValue(identitySchema.Type().TerraformType(ctx), val),
+			Schema: &identitySchema,
+		}
+	}
+
+	tests := map[string]struct {
+		id             string
+		identity       *tfsdk.ResourceIdentity
+		expectID       string
+		expectRoleARN  string
+		expectError    bool
+		expectNullRole bool
+	}{
+		"import ID": {
+			id:             name,
+			expectID:       name,
+			expectNullRole: true,
+		},
+		"import ID with role": {
+			id:            name + "@" + roleARN,
+			expectID:      name,
+			expectRoleARN: roleARN,
+		},
+		"identity": {
+			identity:       identityFromValues(map[string]string{names.AttrName: name}),
+			expectNullRole: true,
+		},
+		"identity with role": {
+			identity:      identityFromValues(map[string]string{names.AttrName: name, names.AttrAssumeRole: roleARN}),
+			expectRoleARN: roleARN,
+		},
+		"identity with invalid role": {
+			identity:    identityFromValues(map[string]string{names.AttrName: name, names.AttrAssumeRole: "not-an-arn"}),
+			expectError: true,
+		},
+	}
+
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ImportStateRequest{ID: tc.id, Identity: tc.identity}
+			resp := resource.ImportStateResponse{State: nullStateFromSchema(ctx, s), Identity: tc.identity}
+
+			icpt.importState(ctx, interceptorOptions[resource.ImportStateRequest, resource.ImportStateResponse]{
+				c:        client,
+				request:  &req,
+				response: &resp,
+				when:     Before,
+			})
+			if tc.expectError {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diags: %s", resp.Diagnostics)
+			}
+
+			if got, want := req.ID, tc.expectID; got != want {
+				t.Errorf("ID = %q, want %q", got, want)
+			}
+
+			if tc.expectNullRole {
+				if !resp.State.Raw.IsNull() {
+					t.Errorf("expected State.Raw to stay null, got %#v", resp.State.Raw)
+				}
+			} else {
+				if got, want := getStateAttributeValue(ctx, t, resp.State, path.Root(names.AttrAssumeRole)), tc.expectRoleARN; got != want {
+					t.Errorf("%s = %q, want %q", names.AttrAssumeRole, got, want)
+				}
+			}
+		})
+	}
+}
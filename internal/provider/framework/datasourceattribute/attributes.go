@@ -6,7 +6,11 @@ package datasourceattribute
 import (
 	"sync"
 
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -26,3 +30,13 @@ var RegionDeprecated = sync.OnceValue(func() schema.StringAttribute {
 		DeprecationMessage: "This attribute will be removed in a future version of the provider.",
 	}
 })
+
+var AssumeRole = sync.OnceValue(func() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: names.ResourceTopLevelAssumeRoleAttributeDescription,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(regexache.MustCompile(`^`+inttypes.IAMRoleARNPatternNoAnchors+`$`), "must be an IAM role ARN"),
+		},
+	}
+})
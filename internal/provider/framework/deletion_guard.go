@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type resourceDeletionGuardInterceptor struct {
+	resourceNoOpCRUDInterceptor
+	isTagged bool
+}
+
+func (r resourceDeletionGuardInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) {
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		response.Diagnostics.Append(r.check(ctx, opts.c, request.State, false)...)
+	}
+}
+
+func (r resourceDeletionGuardInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case After:
+		// Nothing to protect on Create.
+		if request.State.Raw.IsNull() {
+			return
+		}
+
+		// Only work out whether the resource is being replaced if it's protected.
+		typeName, protected, diags := r.protects(ctx, opts.c, request.State)
+		response.Diagnostics.Append(diags...)
+		if diags.HasError() || !protected {
+			return
+		}
+
+		// If the entire plan is null, the resource is planned for destruction.
+		replace := !request.Plan.Raw.IsNull()
+		if replace {
+			// response.RequiresReplace only holds paths added by ModifyPlan methods, not those of schema plan modifiers.
+			if len(response.RequiresReplace) == 0 && !schemaRequiresReplace(ctx, *request) {
+				return
+			}
+		}
+
+		response.Diagnostics.Append(deletionGuardDiag(typeName, replace))
+	}
+}
+
+func (r resourceDeletionGuardInterceptor) check(ctx context.Context, c awsClient, state tfsdk.State, replace bool) diag.Diagnostics {
+	typeName, protected, diags := r.protects(ctx, c, state)
+	if diags.HasError() || !protected {
+		return diags
+	}
+
+	diags.Append(deletionGuardDiag(typeName, replace))
+
+	return diags
+}
+
+// protects returns the resource type name and whether the resource with the specified state is protected by the provider's deletion_guard.
+func (r resourceDeletionGuardInterceptor) protects(ctx context.Context, c awsClient, state tfsdk.State) (string, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The configuration is nil if the deletion guard is overridden.
+	config := c.DeletionGuardConfig(ctx)
+	if config == nil {
+		return "", false, diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return "", false, diags
+	}
+
+	var tagsAll map[string]string
+	if r.isTagged {
+		// tags_all holds the configured tags merged with any default_tags.
+		var stateTagsAll tftags.Map
+		diags.Append(state.GetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...)
+		if diags.HasError() {
+			return "", false, diags
+		}
+		tagsAll = tftags.New(ctx, stateTagsAll).Map()
+	}
+
+	typeName := inContext.TypeName()
+
+	return typeName, config.Protects(typeName, tagsAll), diags
+}
+
+func deletionGuardDiag(typeName string, replace bool) diag.Diagnostic {
+	err := &conns.DeletionGuardError{
+		TypeName: typeName,
+		Replace:  replace,
+	}
+
+	return diag.NewErrorDiagnostic("Deletion Guard", err.Error())
+}
+
+// resourceDeletionGuard refuses to delete, or to plan the destruction or replacement of,
+// resources protected by the provider's deletion_guard.
+func resourceDeletionGuard(isTagged bool) any {
+	return &resourceDeletionGuardInterceptor{
+		isTagged: isTagged,
+	}
+}
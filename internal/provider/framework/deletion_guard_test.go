@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestResourceDeletionGuardInterceptor_ModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	// The description attribute's plan modifier counts its calls and returns a warning.
+	newSchema := func(calls *int) schema.Schema {
+		return schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				names.AttrDescription: schema.StringAttribute{
+					Optional: true,
+					PlanModifiers: []planmodifier.String{
+						warningPlanModifier{calls: calls},
+					},
+				},
+				names.AttrID: schema.StringAttribute{
+					Computed: true,
+				},
+				names.AttrName: schema.StringAttribute{
+					Required: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+		}
+	}
+	prior := map[string]string{
+		names.AttrDescription: "old",
+		names.AttrID:          "id-1",
+		names.AttrName:        "old",
+	}
+	protected := &conns.DeletionGuardConfig{ResourceTypes: []string{"aws_test"}}
+
+	testCases := map[string]struct {
+		config          map[string]string
+		create          bool
+		destroy         bool
+		guard           *conns.DeletionGuardConfig
+		requiresReplace bool // Path added by a resource's ModifyPlan method.
+		expectError     bool
+		expectCalls     int // Calls of the description attribute's plan modifier.
+	}{
+		"create": {
+			config: map[string]string{names.AttrName: "new"},
+			create: true,
+			guard:  protected,
+		},
+		"no changes": {
+			config: map[string]string{names.AttrDescription: "old", names.AttrName: "old"},
+			guard:  protected,
+		},
+		"in-place update": {
+			config:      map[string]string{names.AttrDescription: "new", names.AttrName: "old"},
+			guard:       protected,
+			expectCalls: 1,
+		},
+		"in-place update not protected": {
+			config: map[string]string{names.AttrDescription: "new", names.AttrName: "old"},
+			guard:  &conns.DeletionGuardConfig{ResourceTypes: []string{"aws_other"}},
+		},
+		"in-place update not configured": {
+			config: map[string]string{names.AttrDescription: "new", names.AttrName: "old"},
+		},
+		"RequiresReplace attribute": {
+			config:      map[string]string{names.AttrDescription: "old", names.AttrName: "new"},
+			guard:       protected,
+			expectError: true,
+		},
+		"RequiresReplace attribute not protected": {
+			config: map[string]string{names.AttrDescription: "old", names.AttrName: "new"},
+			guard:  &conns.DeletionGuardConfig{ResourceTypes: []string{"aws_other"}},
+		},
+		"ModifyPlan requires replace": {
+			config:          map[string]string{names.AttrDescription: "new", names.AttrName: "old"},
+			guard:           protected,
+			requiresReplace: true,
+			expectError:     true,
+		},
+		"destroy": {
+			destroy:     true,
+			guard:       protected,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := conns.NewResourceContext(t.Context(), "Test", "test", "aws_test", "", "")
+			var calls int
+			s := newSchema(&calls)
+			client := deletionGuardMockClient{
+				mockClient: mockClient{
+					accountID: "123456789012",
+					region:    "us-west-2", //lintignore:AWSAT003
+				},
+				config: testCase.guard,
+			}
+			icpt := resourceDeletionGuardInterceptor{}
+
+			state := stateFromSchema(ctx, s, prior)
+			if testCase.create {
+				state = nullStateFromSchema(ctx, s)
+			}
+			req := resource.ModifyPlanRequest{
+				Config: configFromSchema(ctx, s, testCase.config),
+				State:  state,
+			}
+			if testCase.destroy {
+				req.Plan = tfsdk.Plan{
+					Raw:    nullStateFromSchema(ctx, s).Raw,
+					Schema: s,
+				}
+			} else {
+				// The planned id is copied from prior state.
+				planned := map[string]string{names.AttrID: prior[names.AttrID]}
+				for k, v := range testCase.config {
+					planned[k] = v
+				}
+				req.Plan = planFromSchema(ctx, s, planned)
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+			if testCase.requiresReplace {
+				resp.RequiresReplace = resp.RequiresReplace.Append(path.Root(names.AttrDescription))
+			}
+
+			icpt.modifyPlan(ctx, interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c:        client,
+				request:  &req,
+				response: &resp,
+				when:     After,
+			})
+
+			if got, want := resp.Diagnostics.HasError(), testCase.expectError; got != want {
+				t.Errorf("HasError = %t, want %t: %s", got, want, resp.Diagnostics)
+			}
+			// Diagnostics from re-run plan modifiers are discarded.
+			if got, want := resp.Diagnostics.WarningsCount(), 0; got != want {
+				t.Errorf("WarningsCount = %d, want %d: %s", got, want, resp.Diagnostics)
+			}
+			if got, want := calls, testCase.expectCalls; got != want {
+				t.Errorf("plan modifier calls = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+type deletionGuardMockClient struct {
+	mockClient
+	config *conns.DeletionGuardConfig
+}
+
+func (c deletionGuardMockClient) DeletionGuardConfig(context.Context) *conns.DeletionGuardConfig {
+	return c.config
+}
+
+type warningPlanModifier struct {
+	calls *int
+}
+
+func (m warningPlanModifier) Description(context.Context) string {
+	return "Returns a warning."
+}
+
+func (m warningPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m warningPlanModifier) PlanModifyString(_ context.Context, _ planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	*m.calls++
+	resp.Diagnostics.AddWarning("Plan Modifier", "Warning from plan modifier.")
+}
@@ -18,6 +18,14 @@ func NewIdentitySchema(identitySpec inttypes.Identity) identityschema.Schema {
 	}
 }
 
+// AssumeRoleAttribute returns the schema for a top-level "assume_role" identity attribute.
+// The attribute is only used to specify a per-resource IAM role when importing by identity.
+func AssumeRoleAttribute() identityschema.Attribute {
+	return identityschema.StringAttribute{
+		OptionalForImport: true,
+	}
+}
+
 func newIdentityAttribute(attribute inttypes.IdentityAttribute) identityschema.Attribute {
 	required := attribute.Required()
 	var optional bool
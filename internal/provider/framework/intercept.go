@@ -18,18 +18,27 @@ import (
 	tfiter "github.com/hashicorp/terraform-provider-aws/internal/iter"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type awsClient interface {
 	AccountID(context.Context) string
+	AccountIDForAssumeRole(roleARN string) string
 	Region(context.Context) string
 	DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig
+	DeletionGuardConfig(ctx context.Context) *conns.DeletionGuardConfig
 	IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig
 	Partition(context.Context) string
+	QuotaChecksConfig(context.Context) *conns.QuotaChecksConfig
+	CheckQuota(ctx context.Context, typeName string, getAttribute func(string) (string, bool)) error
 	ServicePackage(_ context.Context, name string) conns.ServicePackage
 	TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig
+	ValidateInContextAssumeRole(ctx context.Context) error
 	ValidateInContextRegionInPartition(ctx context.Context) error
 	AwsConfig(context.Context) aws.Config
+	FlushSpans(ctx context.Context)
+	StartSpan(ctx context.Context, operation string) (context.Context, trace.Span)
 }
 
 type interceptorOptions[Request, Response any] struct {
@@ -383,6 +392,18 @@ type innerFunc[Request, Response any] func(ctx context.Context, request Request,
 // interceptedHandler returns a handler that runs any interceptors.
 func interceptedHandler[Request interceptedRequest, Response interceptedResponse](interceptors []interceptorFunc[Request, Response], f innerFunc[Request, Response], hasError hasErrorFn[Response], c awsClient) func(context.Context, Request, *Response) {
 	return func(ctx context.Context, request Request, response *Response) {
+		if operation := spanOperation(request); operation != "" && c != nil {
+			var span trace.Span
+			ctx, span = c.StartSpan(ctx, operation)
+			defer func() {
+				if hasError(response) {
+					span.SetStatus(codes.Error, "operation returned error diagnostics")
+				}
+				span.End()
+				c.FlushSpans(ctx)
+			}()
+		}
+
 		opts := interceptorOptions[Request, Response]{
 			c:        c,
 			request:  &request,
@@ -419,6 +440,35 @@ func interceptedHandler[Request interceptedRequest, Response interceptedResponse
 	}
 }
 
+// spanOperation returns the name of the traced operation for the specified request.
+// Schema requests are not traced.
+func spanOperation[Request interceptedRequest](request Request) string {
+	switch any(request).(type) {
+	case action.InvokeRequest:
+		return "Invoke"
+	case datasource.ReadRequest, resource.ReadRequest:
+		return "Read"
+	case ephemeral.OpenRequest:
+		return "Open"
+	case ephemeral.RenewRequest:
+		return "Renew"
+	case ephemeral.CloseRequest:
+		return "Close"
+	case resource.CreateRequest:
+		return "Create"
+	case resource.UpdateRequest:
+		return "Update"
+	case resource.DeleteRequest:
+		return "Delete"
+	case resource.ModifyPlanRequest:
+		return "ModifyPlan"
+	case resource.ImportStateRequest:
+		return "ImportState"
+	default:
+		return ""
+	}
+}
+
 type hasErrorFn[Response interceptedResponse] func(response *Response) bool
 
 func dataSourceSchemaHasError(response *datasource.SchemaResponse) bool {
@@ -6,7 +6,11 @@ package listresourceattribute
 import (
 	"sync"
 
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -16,3 +20,13 @@ var Region = sync.OnceValue(func() schema.StringAttribute {
 		Description: names.ListResourceTopLevelRegionAttributeDescription,
 	}
 })
+
+var AssumeRole = sync.OnceValue(func() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: names.ListResourceTopLevelAssumeRoleAttributeDescription,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(regexache.MustCompile(`^`+inttypes.IAMRoleARNPatternNoAnchors+`$`), "must be an IAM role ARN"),
+		},
+	}
+})
@@ -33,6 +33,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -109,6 +110,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"audit_log_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to which a JSON line is appended for every AWS API call. Each line records the service, operation, Region, account ID, resource type, request ID, latency, retry count and any error code.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -149,6 +154,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
 			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Reject any AWS API operation that is not classified as a read, such as Describe*, Get*, Head* and List* operations.",
+			},
 			"region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
@@ -171,6 +180,12 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "The secret key for API operations. You can retrieve this\nfrom the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_quota_checks": schema.StringAttribute{
+				Optional: true,
+				Description: `The severity with which to report planned resource creations that would exceed AWS service quotas. ` +
+					`Valid values are "error", "warning", and "disabled". ` +
+					`When unset or "disabled", service quotas will not be checked.`,
+			},
 			"shared_config_files": schema.ListAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -329,6 +344,84 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.ListNestedBlock{
+							Description: "Resource tags to default across the resources matching the rule's conditions. Rules are merged in order.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"exclude_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource type names or glob patterns, e.g. `aws_rds_*`, to which the rule does not apply.",
+									},
+									"regions": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "AWS Regions in which the rule applies. Defaults to all Regions.",
+									},
+									"resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource type names or glob patterns, e.g. `aws_rds_*`, to which the rule applies. Defaults to all resource types.",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Resource tags to default across the matching resources.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"default_timeouts": schema.ListNestedBlock{
+				Description: "Configuration block with default resource operation timeouts for matching resource types. " +
+					"Timeouts configured in a resource's `timeouts` block take precedence.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"create": schema.StringAttribute{
+							CustomType:  timetypes.GoDurationType{},
+							Optional:    true,
+							Description: "Default Create timeout. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"delete": schema.StringAttribute{
+							CustomType:  timetypes.GoDurationType{},
+							Optional:    true,
+							Description: "Default Delete timeout. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Description: "Resource type names or glob patterns, e.g. `aws_rds_*`, to which the default timeouts apply.",
+						},
+						"update": schema.StringAttribute{
+							CustomType:  timetypes.GoDurationType{},
+							Optional:    true,
+							Description: "Default Update timeout. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+					},
+				},
+			},
+			"deletion_guard": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to refuse the deletion or replacement of matching resources. " +
+					"Can be overridden by setting the " + conns.DeletionGuardOverrideEnvVar + " environment variable to `true`.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource type names or glob patterns, e.g. `aws_rds_*`, of resources to protect.",
+						},
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resources whose `tags_all` contain all of these tags are protected.",
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -345,6 +438,11 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 							Description: "Resource tag key prefixes to ignore across all resources. " +
 								"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 						},
+						"key_regexes": schema.SetAttribute{
+							ElementType: fwtypes.RegexpType,
+							Optional:    true,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
 						"keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -352,6 +450,42 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 								"Can also be configured with the " + tftags.IgnoreTagsKeysEnvVar + " environment variable.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"key_value": schema.ListNestedBlock{
+							Description: "Resource tags to ignore across all resources by key regular expression and value.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_regex": schema.StringAttribute{
+										CustomType:  fwtypes.RegexpType,
+										Required:    true,
+										Description: "Regular expression matching resource tag keys.",
+									},
+									"value": schema.StringAttribute{
+										Required:    true,
+										Description: "Resource tag value.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tracing": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to export OpenTelemetry traces of provider operations and AWS API calls.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"file": schema.StringAttribute{
+							Optional:    true,
+							Description: "Path of a file to which spans are appended as OTLP JSON Lines.",
+						},
+						"otlp_endpoint": schema.StringAttribute{
+							Optional:    true,
+							Description: "URL of an OTLP/HTTP collector endpoint to which spans are exported.",
+						},
+					},
 				},
 			},
 		},
@@ -437,6 +571,8 @@ func (p *frameworkProvider) ListResources(_ context.Context) []func() list.ListR
 func (p *frameworkProvider) initialize(ctx context.Context) {
 	log.Printf("Initializing Terraform AWS Provider (Framework-style)...")
 
+	listResourceTypeNames := make(map[string]struct{})
+
 	for sp := range p.servicePackages {
 		servicePackageName := sp.ServicePackageName()
 
@@ -456,6 +592,7 @@ func (p *frameworkProvider) initialize(ctx context.Context) {
 
 		if v, ok := sp.(conns.ServicePackageWithFrameworkListResources); ok {
 			for listResourceSpec := range v.FrameworkListResources(ctx) {
+				listResourceTypeNames[listResourceSpec.TypeName] = struct{}{}
 				p.listResources = append(p.listResources, func() list.ListResource { //nolint:contextcheck // must be a func()
 					return newWrappedListResourceFramework(listResourceSpec, servicePackageName)
 				})
@@ -463,6 +600,7 @@ func (p *frameworkProvider) initialize(ctx context.Context) {
 		}
 		if v, ok := sp.(conns.ServicePackageWithSDKListResources); ok {
 			for listResourceSpec := range v.SDKListResources(ctx) {
+				listResourceTypeNames[listResourceSpec.TypeName] = struct{}{}
 				p.listResources = append(p.listResources, func() list.ListResource { //nolint:contextcheck // must be a func()
 					return newWrappedListResourceSDK(listResourceSpec, servicePackageName)
 				})
@@ -483,6 +621,23 @@ func (p *frameworkProvider) initialize(ctx context.Context) {
 			}
 		}
 	}
+
+	// Resource types without a hand-written list resource may be listable via the Resource Groups Tagging API.
+	for sp := range p.servicePackages {
+		servicePackageName := sp.ServicePackageName()
+
+		for _, resourceSpec := range sp.SDKResources(ctx) {
+			if _, ok := listResourceTypeNames[resourceSpec.TypeName]; ok {
+				continue
+			}
+
+			if listResourceSpec, ok := taggedListResourceSpec(resourceSpec); ok {
+				p.listResources = append(p.listResources, func() list.ListResource { //nolint:contextcheck // must be a func()
+					return newWrappedListResourceSDK(listResourceSpec, servicePackageName)
+				})
+			}
+		}
+	}
 }
 
 // validateResourceSchemas is called from `New` to validate Terraform Plugin Framework-style resource schemas.
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// resourceCheckQuota checks planned resource creations against any applicable AWS service quota.
+func resourceCheckQuota() resourceModifyPlanInterceptor {
+	return &resourceCheckQuotaInterceptor{}
+}
+
+type resourceCheckQuotaInterceptor struct{}
+
+func (r resourceCheckQuotaInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	config := c.QuotaChecksConfig(ctx)
+	if config == nil {
+		return
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return
+	}
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		// Only creations are counted.
+		if !request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+			return
+		}
+
+		getAttribute := func(name string) (string, bool) {
+			var v types.String
+			if diags := request.Plan.GetAttribute(ctx, path.Root(name), &v); diags.HasError() {
+				return "", false
+			}
+			if v.IsNull() || v.IsUnknown() {
+				return "", false
+			}
+			return v.ValueString(), true
+		}
+
+		if err := c.CheckQuota(ctx, inContext.TypeName(), getAttribute); err != nil {
+			summary := "Service Quota Check"
+
+			switch config.Severity {
+			case "warning":
+				response.Diagnostics.AddWarning(summary, err.Error())
+			default:
+				response.Diagnostics.AddError(summary, err.Error())
+			}
+		}
+	}
+}
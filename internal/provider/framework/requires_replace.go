@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// schemaRequiresReplace returns whether the plan modifiers of any top-level attribute or block require the resource's replacement.
+// The framework runs schema plan modifiers before the resource's ModifyPlan method but doesn't pass on the paths they mark as
+// requiring replacement, so the plan modifiers of each attribute or block whose planned value differs from its prior state are run again.
+// The framework has already returned the plan modifiers' diagnostics, so those from the re-run are discarded.
+// Plan modifiers of attributes nested within attributes or blocks are not considered.
+func schemaRequiresReplace(ctx context.Context, request resource.ModifyPlanRequest) bool {
+	elements := make(map[string]any)
+	for name, a := range request.Plan.Schema.GetAttributes() {
+		elements[name] = a
+	}
+	for name, b := range request.Plan.Schema.GetBlocks() {
+		elements[name] = b
+	}
+
+	for name, element := range elements {
+		p := path.Root(name)
+
+		var values [3]attr.Value // Config, plan and state.
+		var diags diag.Diagnostics
+		diags.Append(request.Config.GetAttribute(ctx, p, &values[0])...)
+		diags.Append(request.Plan.GetAttribute(ctx, p, &values[1])...)
+		diags.Append(request.State.GetAttribute(ctx, p, &values[2])...)
+		if diags.HasError() {
+			continue
+		}
+
+		if values[1].Equal(values[2]) {
+			continue
+		}
+
+		if planModifiersRequireReplace(ctx, request, p, element, values) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// planModifiersRequireReplace runs the plan modifiers of the specified attribute or block and returns whether any requires replacement.
+// Plan modifiers are not passed the resource's private state, so they cannot modify it.
+func planModifiersRequireReplace(ctx context.Context, request resource.ModifyPlanRequest, p path.Path, element any, values [3]attr.Value) bool {
+	switch a := element.(type) {
+	case interface{ BoolPlanModifiers() []planmodifier.Bool }:
+		config, plan, state, ok := convertValues(ctx, values, basetypes.BoolValuable.ToBoolValue)
+		if !ok {
+			return false
+		}
+		for _, m := range a.BoolPlanModifiers() {
+			req := planmodifier.BoolRequest{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: config, Plan: request.Plan, PlanValue: plan, State: request.State, StateValue: state}
+			resp := planmodifier.BoolResponse{PlanValue: plan}
+			m.PlanModifyBool(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true
+			}
+		}
+	case interface{ DynamicPlanModifiers() []planmodifier.Dynamic }:
+		config, plan, state, ok := convertValues(ctx, values, basetypes.DynamicValuable.ToDynamicValue)
+		if !ok {
+			return false
+		}
+		for _, m := range a.DynamicPlanModifiers() {
+			req := planmodifier.DynamicRequest{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: config, Plan: request.Plan, PlanValue: plan, State: request.State, StateValue: state}
+			resp := planmodifier.DynamicResponse{PlanValue: plan}
+			m.PlanModifyDynamic(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true
+			}
+		}
+	case interface{ Float32PlanModifiers() []planmodifier.Float32 }:
+		config, plan, state, ok := convertValues(ctx, values, basetypes.Float32Valuable.ToFloat32Value)
+		if !ok {
+			return false
+		}
+		for _, m := range a.Float32PlanModifiers() {
+			req := planmodifier.Float32Request{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: config, Plan: request.Plan, PlanValue: plan, State: request.State, StateValue: state}
+			resp := planmodifier.Float32Response{PlanValue: plan}
+			m.PlanModifyFloat32(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true
+			}
+		}
+	case interface{ Float64PlanModifiers() []planmodifier.Float64 }:
+		config, plan, state, ok := convertValues(ctx, values, basetypes.Float64Valuable.ToFloat64Value)
+		if !ok {
+			return false
+		}
+		for _, m := range a.Float64PlanModifiers() {
+			req := planmodifier.Float64Request{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: config, Plan: request.Plan, PlanValue: plan, State: request.State, StateValue: state}
+			resp := planmodifier.Float64Response{PlanValue: plan}
+			m.PlanModifyFloat64(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true
+			}
+		}
+	case interface{ Int32PlanModifiers() []planmodifier.Int32 }:
+		config, plan, state, ok := convertValues(ctx, values, basetypes.Int32Valuable.ToInt32Value)
+		if !ok {
+			return false
+		}
+		for _, m := range a.Int32PlanModifiers() {
+			req := planmodifier.Int32Request{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: config, Plan: request.Plan, PlanValue: plan, State: request.State, StateValue: state}
+			resp := planmodifier.Int32Response{PlanValue: plan}
+			m.PlanModifyInt32(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true
+			}
+		}
+	case interface{ Int64PlanModifiers() []planmodifier.Int64 }:
+		config, plan, state, ok := convertValues(ctx, values, basetypes.Int64Valuable.ToInt64Value)
+		if !ok {
+			return false
+		}
+		for _, m := range a.Int64PlanModifiers() {
+			req := planmodifier.Int64Request{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: config, Plan: request.Plan, PlanValue: plan, State: request.State, StateValue: state}
+			resp := planmodifier.Int64Response{PlanValue: plan}
+			m.PlanModifyInt64(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true
+			}
+		}
+	case interface{ ListPlanModifiers() []planmodifier.List }:
+		config, plan, state, ok := convertValues(ctx, values, basetypes.ListValuable.ToListValue)
+		if !ok {
+			return false
+		}
+		for _, m := range a.ListPlanModifiers() {
+			req := planmodifier.ListRequest{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: config, Plan: request.Plan, PlanValue: plan, State: request.State, StateValue: state}
+			resp := planmodifier.ListResponse{PlanValue: plan}
+			m.PlanModifyList(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true
+			}
+		}
+	case interface{ MapPlanModifiers() []planmodifier.Map }:
+		config, plan, state, ok := convertValues(ctx, values, basetypes.MapValuable.ToMapValue)
+		if !ok {
+			return false
+		}
+		for _, m := range a.MapPlanModifiers() {
+			req := planmodifier.MapRequest{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: config, Plan: request.Plan, PlanValue: plan, State: request.State, StateValue: state}
+			resp := planmodifier.MapResponse{PlanValue: plan}
+			m.PlanModifyMap(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true
+			}
+		}
+	case interface{ NumberPlanModifiers() []planmodifier.Number }:
+		config, plan, state, ok := convertValues(ctx, values, basetypes.NumberValuable.ToNumberValue)
+		if !ok {
+			return false
+		}
+		for _, m := range a.NumberPlanModifiers() {
+			req := planmodifier.NumberRequest{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: config, Plan: request.Plan, PlanValue: plan, State: request.State, StateValue: state}
+			resp := planmodifier.NumberResponse{PlanValue: plan}
+			m.PlanModifyNumber(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true
+			}
+		}
+	case interface{ ObjectPlanModifiers() []planmodifier.Object }:
+		config, plan, state, ok := convertValues(ctx, values, basetypes.ObjectValuable.ToObjectValue)
+		if !ok {
+			return false
+		}
+		for _, m := range a.ObjectPlanModifiers() {
+			req := planmodifier.ObjectRequest{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: config, Plan: request.Plan, PlanValue: plan, State: request.State, StateValue: state}
+			resp := planmodifier.ObjectResponse{PlanValue: plan}
+			m.PlanModifyObject(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true
+			}
+		}
+	case interface{ SetPlanModifiers() []planmodifier.Set }:
+		config, plan, state, ok := convertValues(ctx, values, basetypes.SetValuable.ToSetValue)
+		if !ok {
+			return false
+		}
+		for _, m := range a.SetPlanModifiers() {
+			req := planmodifier.SetRequest{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: config, Plan: request.Plan, PlanValue: plan, State: request.State, StateValue: state}
+			resp := planmodifier.SetResponse{PlanValue: plan}
+			m.PlanModifySet(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true
+			}
+		}
+	case interface{ StringPlanModifiers() []planmodifier.String }:
+		config, plan, state, ok := convertValues(ctx, values, basetypes.StringValuable.ToStringValue)
+		if !ok {
+			return false
+		}
+		for _, m := range a.StringPlanModifiers() {
+			req := planmodifier.StringRequest{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: config, Plan: request.Plan, PlanValue: plan, State: request.State, StateValue: state}
+			resp := planmodifier.StringResponse{PlanValue: plan}
+			m.PlanModifyString(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// convertValues converts config, plan and state values to the framework base type expected by plan modifiers.
+func convertValues[T any, V attr.Value](ctx context.Context, values [3]attr.Value, f func(T, context.Context) (V, diag.Diagnostics)) (V, V, V, bool) {
+	var converted [3]V
+
+	for i, value := range values {
+		v, ok := value.(T)
+		if !ok {
+			return converted[0], converted[1], converted[2], false
+		}
+		c, diags := f(v, ctx)
+		if diags.HasError() {
+			return converted[0], converted[1], converted[2], false
+		}
+		converted[i] = c
+	}
+
+	return converted[0], converted[1], converted[2], true
+}
@@ -0,0 +1,236 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/tags/tagpolicy"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// taggedListResourceSpec returns a list resource specification for the SDKv2 resource type
+// that discovers resources via the Resource Groups Tagging API, or false if the resource type
+// cannot be listed this way.
+// Only resource types with ARN identity whose ID is the ARN, and which are mapped to a Tagris
+// resource type in tagpolicy.Lookup, are supported.
+// List resource type names must match a managed resource type name, so a list resource
+// is registered per resource type and each must be documented in website/docs/list-resources.
+func taggedListResourceSpec(spec *inttypes.ServicePackageSDKResource) (*inttypes.ServicePackageSDKListResource, bool) {
+	identity := spec.Identity
+	if !identity.IsARN || !slices.Contains(identity.IdentityDuplicateAttrs, names.AttrID) {
+		return nil, false
+	}
+
+	tagrisTypes := tagpolicy.ResourceTypes(spec.TypeName)
+	if len(tagrisTypes) == 0 {
+		return nil, false
+	}
+
+	return &inttypes.ServicePackageSDKListResource{
+		Factory: func() inttypes.ListResourceForSDK {
+			l := taggedListResource{
+				identityAttribute: identity.IdentityAttribute,
+				resourceFactory:   spec.Factory,
+				tagrisTypes:       tagrisTypes,
+			}
+			l.SetResourceSchema(spec.Factory())
+
+			return &l
+		},
+		TypeName: spec.TypeName,
+		Name:     spec.Name,
+		Tags:     spec.Tags,
+		Region:   spec.Region,
+		Identity: identity,
+	}, true
+}
+
+var _ list.ListResourceWithRawV5Schemas = &taggedListResource{}
+
+// taggedListResource is a generic list resource backed by the Resource Groups Tagging API GetResources operation.
+type taggedListResource struct {
+	framework.ListResourceWithSDKv2Resource
+	identityAttribute string
+	resourceFactory   func() *schema.Resource
+	tagrisTypes       []string
+}
+
+type taggedListResourceModel struct {
+	framework.WithRegionModel
+	TagFilters fwtypes.ListNestedObjectValueOf[tagFilterModel] `tfsdk:"tag_filter"`
+}
+
+type tagFilterModel struct {
+	Key    types.String                      `tfsdk:"key"`
+	Values fwtypes.ListValueOf[types.String] `tfsdk:"values"`
+}
+
+func (l *taggedListResource) ListResourceConfigSchema(ctx context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{},
+		Blocks: map[string]listschema.Block{
+			"tag_filter": listschema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tagFilterModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(50),
+				},
+				NestedObject: listschema.NestedBlockObject{
+					Attributes: map[string]listschema.Attribute{
+						names.AttrKey: listschema.StringAttribute{
+							Required: true,
+						},
+						names.AttrValues: listschema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(20),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (l *taggedListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.ResourceGroupsTaggingAPIClient(ctx)
+
+	var query taggedListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input resourcegroupstaggingapi.GetResourcesInput
+	if diags := fwflex.Expand(ctx, query, &input); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	for _, v := range l.tagrisTypes {
+		if filter := tagpolicy.ResourceTypeFilter(v); !slices.Contains(input.ResourceTypeFilters, filter) {
+			input.ResourceTypeFilters = append(input.ResourceTypeFilters, filter)
+		}
+	}
+
+	tflog.Info(ctx, "Listing resources", map[string]any{
+		"resource_type_filters": input.ResourceTypeFilters,
+	})
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for item, err := range listTaggedResources(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			arn := aws.ToString(item.ResourceARN)
+			if !slices.ContainsFunc(l.tagrisTypes, func(v string) bool {
+				return tagpolicy.MatchesResourceType(arn, v)
+			}) {
+				continue
+			}
+
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), arn)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(arn)
+			rd.Set(l.identityAttribute, arn)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading resource")
+				if diags := readSDKResource(ctx, l.resourceFactory(), rd, awsClient); diags.HasError() {
+					tflog.Error(ctx, "Reading resource", map[string]any{
+						"diags": sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				if rd.Id() == "" {
+					tflog.Warn(ctx, "Resource disappeared during listing, skipping")
+					continue
+				}
+			}
+
+			result.DisplayName = arn
+			for _, tag := range item.Tags {
+				if aws.ToString(tag.Key) == "Name" {
+					result.DisplayName = fmt.Sprintf("%s (%s)", aws.ToString(tag.Value), arn)
+					break
+				}
+			}
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// readSDKResource calls the SDKv2 resource's Read handler.
+func readSDKResource(ctx context.Context, r *schema.Resource, d *schema.ResourceData, meta any) diag.Diagnostics {
+	switch {
+	case r.ReadWithoutTimeout != nil:
+		return r.ReadWithoutTimeout(ctx, d, meta)
+	case r.ReadContext != nil:
+		return r.ReadContext(ctx, d, meta)
+	default:
+		return sdkdiag.AppendErrorf(nil, "resource type has no Read handler")
+	}
+}
+
+func listTaggedResources(ctx context.Context, conn *resourcegroupstaggingapi.Client, input *resourcegroupstaggingapi.GetResourcesInput) iter.Seq2[awstypes.ResourceTagMapping, error] {
+	return func(yield func(awstypes.ResourceTagMapping, error) bool) {
+		pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.ResourceTagMapping{}, fmt.Errorf("listing Resource Groups Tagging API resources: %w", err))
+				return
+			}
+
+			for _, item := range page.ResourceTagMappingList {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
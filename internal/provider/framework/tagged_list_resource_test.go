@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestTaggedListResourceSpec(t *testing.T) {
+	t.Parallel()
+
+	factory := func() *schema.Resource {
+		return &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrARN: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		spec *inttypes.ServicePackageSDKResource
+		want bool
+	}{
+		"ARN identity": {
+			spec: &inttypes.ServicePackageSDKResource{
+				Factory:  factory,
+				TypeName: "aws_sfn_activity",
+				Identity: inttypes.RegionalARNIdentity(inttypes.WithIdentityDuplicateAttrs(names.AttrID)),
+			},
+			want: true,
+		},
+		"ARN identity, ID is not the ARN": {
+			spec: &inttypes.ServicePackageSDKResource{
+				Factory:  factory,
+				TypeName: "aws_sfn_activity",
+				Identity: inttypes.RegionalARNIdentity(),
+			},
+		},
+		"parameterized identity": {
+			spec: &inttypes.ServicePackageSDKResource{
+				Factory:  factory,
+				TypeName: "aws_sfn_activity",
+				Identity: inttypes.RegionalSingleParameterIdentity(names.AttrName),
+			},
+		},
+		"no Tagris resource type": {
+			spec: &inttypes.ServicePackageSDKResource{
+				Factory:  factory,
+				TypeName: "aws_example_thing",
+				Identity: inttypes.RegionalARNIdentity(inttypes.WithIdentityDuplicateAttrs(names.AttrID)),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := taggedListResourceSpec(testCase.spec)
+
+			if ok != testCase.want {
+				t.Fatalf("taggedListResourceSpec ok = %t, want %t", ok, testCase.want)
+			}
+			if !ok {
+				return
+			}
+
+			if got, want := got.TypeName, testCase.spec.TypeName; got != want {
+				t.Errorf("TypeName = %q, want %q", got, want)
+			}
+
+			v := got.Factory()
+			l, ok := v.(*taggedListResource)
+			if !ok {
+				t.Fatalf("Factory returned %T, want *taggedListResource", v)
+			}
+			if got, want := l.identityAttribute, names.AttrARN; got != want {
+				t.Errorf("identityAttribute = %q, want %q", got, want)
+			}
+			if got, want := l.tagrisTypes, []string{"states:activity"}; len(got) != 1 || got[0] != want[0] {
+				t.Errorf("tagrisTypes = %v, want %v", got, want)
+			}
+		})
+	}
+}
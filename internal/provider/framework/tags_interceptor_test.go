@@ -74,7 +74,7 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 	ctx := t.Context()
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
-		ctx = conns.NewResourceContext(ctx, "Test", "test", "aws_test", "")
+		ctx = conns.NewResourceContext(ctx, "Test", "test", "aws_test", "", "")
 		if v, ok := meta.(awsClient); ok {
 			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx), v.TagPolicyConfig(ctx))
 		}
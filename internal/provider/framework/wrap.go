@@ -58,6 +58,8 @@ func newWrappedDataSource(spec *inttypes.ServicePackageFrameworkDataSource, serv
 		v := spec.Region.Value()
 
 		interceptors = append(interceptors, dataSourceInjectRegionAttribute(v.IsOverrideDeprecated))
+		interceptors = append(interceptors, dataSourceInjectAssumeRoleAttribute())
+		interceptors = append(interceptors, dataSourceValidateAssumeRole())
 		if v.IsValidateOverrideInPartition {
 			interceptors = append(interceptors, dataSourceValidateRegion())
 		}
@@ -81,7 +83,7 @@ func newWrappedDataSource(spec *inttypes.ServicePackageFrameworkDataSource, serv
 // context is run on all wrapped methods before any interceptors.
 func (w *wrappedDataSource) context(ctx context.Context, getAttribute getAttributeFunc, providerMeta *tfsdk.Config, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var overrideRegion string
+	var overrideAssumeRole, overrideRegion string
 
 	var isRegionOverrideEnabled bool
 	if regionSpec := w.spec.Region; !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
@@ -96,9 +98,16 @@ func (w *wrappedDataSource) context(ctx context.Context, getAttribute getAttribu
 		}
 
 		overrideRegion = target.ValueString()
+
+		diags.Append(getAttribute(ctx, path.Root(names.AttrAssumeRole), &target)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		overrideAssumeRole = target.ValueString()
 	}
 
-	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion, overrideAssumeRole)
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
@@ -227,6 +236,8 @@ func newWrappedEphemeralResource(spec *inttypes.ServicePackageEphemeralResource,
 		v := spec.Region.Value()
 
 		interceptors = append(interceptors, ephemeralResourceInjectRegionAttribute())
+		interceptors = append(interceptors, ephemeralResourceInjectAssumeRoleAttribute())
+		interceptors = append(interceptors, ephemeralResourceValidateAssumeRole())
 		if v.IsValidateOverrideInPartition {
 			interceptors = append(interceptors, ephemeralResourceValidateRegion())
 		}
@@ -246,7 +257,7 @@ func newWrappedEphemeralResource(spec *inttypes.ServicePackageEphemeralResource,
 // context is run on all wrapped methods before any interceptors.
 func (w *wrappedEphemeralResource) context(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var overrideRegion string
+	var overrideAssumeRole, overrideRegion string
 
 	var isRegionOverrideEnabled bool
 	if regionSpec := w.spec.Region; !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
@@ -261,9 +272,16 @@ func (w *wrappedEphemeralResource) context(ctx context.Context, getAttribute get
 		}
 
 		overrideRegion = target.ValueString()
+
+		diags.Append(getAttribute(ctx, path.Root(names.AttrAssumeRole), &target)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		overrideAssumeRole = target.ValueString()
 	}
 
-	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion, overrideAssumeRole)
 	if c != nil {
 		ctx = c.RegisterLogger(ctx)
 		ctx = fwflex.RegisterLogger(ctx)
@@ -398,6 +416,8 @@ func newWrappedAction(spec *inttypes.ServicePackageAction, servicePackageName st
 		v := spec.Region.Value()
 
 		interceptors = append(interceptors, actionInjectRegionAttribute())
+		interceptors = append(interceptors, actionInjectAssumeRoleAttribute())
+		interceptors = append(interceptors, actionValidateAssumeRole())
 		if v.IsValidateOverrideInPartition {
 			interceptors = append(interceptors, actionValidateRegion())
 		}
@@ -416,7 +436,7 @@ func newWrappedAction(spec *inttypes.ServicePackageAction, servicePackageName st
 // context is run on all wrapped methods before any interceptors.
 func (w *wrappedAction) context(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var overrideRegion string
+	var overrideAssumeRole, overrideRegion string
 
 	var isRegionOverrideEnabled bool
 	if regionSpec := w.spec.Region; !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
@@ -431,9 +451,16 @@ func (w *wrappedAction) context(ctx context.Context, getAttribute getAttributeFu
 		}
 
 		overrideRegion = target.ValueString()
+
+		diags.Append(getAttribute(ctx, path.Root(names.AttrAssumeRole), &target)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		overrideAssumeRole = target.ValueString()
 	}
 
-	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion, overrideAssumeRole)
 	if c != nil {
 		ctx = c.RegisterLogger(ctx)
 		ctx = fwflex.RegisterLogger(ctx)
@@ -550,12 +577,17 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 		v := spec.Region.Value()
 
 		interceptors = append(interceptors, resourceInjectRegionAttribute(v.IsOverrideDeprecated))
+		interceptors = append(interceptors, resourceInjectAssumeRoleAttribute())
+		interceptors = append(interceptors, resourceValidateAssumeRole())
 		if v.IsValidateOverrideInPartition {
 			interceptors = append(interceptors, resourceValidateRegion())
 		}
 		interceptors = append(interceptors, resourceDefaultRegion())
 		interceptors = append(interceptors, resourceForceNewIfRegionChanges())
+		interceptors = append(interceptors, resourceForceNewIfAssumeRoleAccountChanges())
 		interceptors = append(interceptors, resourceSetRegionInState())
+		// The "@<role-arn>" suffix is stripped from the import ID before any "@<region>" suffix.
+		interceptors = append(interceptors, resourceImportAssumeRole())
 		if spec.Identity.HasInherentRegion() {
 			interceptors = append(interceptors, resourceImportRegionNoDefault())
 		} else {
@@ -568,6 +600,9 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 		interceptors = append(interceptors, resourceValidateRequiredTags())
 	}
 
+	interceptors = append(interceptors, resourceDeletionGuard(!tfunique.IsHandleNil(spec.Tags)))
+	interceptors = append(interceptors, resourceCheckQuota())
+
 	inner, _ := spec.Factory(context.TODO())
 
 	if len(spec.Identity.Attributes) == 0 {
@@ -605,7 +640,7 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 // context is run on all wrapped methods before any interceptors.
 func (w *wrappedResource) context(ctx context.Context, getAttribute getAttributeFunc, providerMeta *tfsdk.Config, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var overrideRegion string
+	var overrideAssumeRole, overrideRegion string
 
 	var isRegionOverrideEnabled bool
 	if regionSpec := w.spec.Region; !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
@@ -620,11 +655,19 @@ func (w *wrappedResource) context(ctx context.Context, getAttribute getAttribute
 		}
 
 		overrideRegion = target.ValueString()
+
+		diags.Append(getAttribute(ctx, path.Root(names.AttrAssumeRole), &target)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		overrideAssumeRole = target.ValueString()
 	}
 
-	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion, overrideAssumeRole)
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
+		ctx = conns.NewDefaultTimeoutsContext(ctx, c.DefaultTimeoutsConfig(ctx).ResourceTimeouts(w.spec.TypeName))
 		ctx = c.RegisterLogger(ctx)
 		ctx = fwflex.RegisterLogger(ctx)
 	}
@@ -837,6 +880,11 @@ type wrappedResourceWithIdentity struct {
 func (w *wrappedResourceWithIdentity) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
 	if len(w.spec.Identity.Attributes) > 0 {
 		resp.IdentitySchema = identity.NewIdentitySchema(w.spec.Identity)
+
+		if v := w.spec.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+			// Inject a top-level "assume_role" identity attribute.
+			resp.IdentitySchema.Attributes[names.AttrAssumeRole] = identity.AssumeRoleAttribute()
+		}
 	}
 }
 
@@ -860,6 +908,7 @@ func newWrappedListResourceFramework(spec *inttypes.ServicePackageFrameworkListR
 
 	if isRegionOverrideEnabled {
 		interceptors = append(interceptors, listResourceInjectRegionAttribute())
+		interceptors = append(interceptors, listResourceInjectAssumeRoleAttribute())
 		// TODO: validate region in partition, needs tweaked error message
 	}
 
@@ -895,7 +944,7 @@ func newWrappedListResourceFramework(spec *inttypes.ServicePackageFrameworkListR
 // context is run on all wrapped methods before any interceptors.
 func (w *wrappedListResourceFramework) context(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var overrideRegion string
+	var overrideAssumeRole, overrideRegion string
 
 	var isRegionOverrideEnabled bool
 	if regionSpec := w.spec.Region; !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
@@ -914,9 +963,16 @@ func (w *wrappedListResourceFramework) context(ctx context.Context, getAttribute
 		} else {
 			overrideRegion = target.ValueString()
 		}
+
+		diags.Append(getAttribute(ctx, path.Root(names.AttrAssumeRole), &target)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		overrideAssumeRole = target.ValueString()
 	}
 
-	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion, overrideAssumeRole)
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
@@ -995,6 +1051,7 @@ func newWrappedListResourceSDK(spec *inttypes.ServicePackageSDKListResource, ser
 
 	if isRegionOverrideEnabled {
 		interceptors = append(interceptors, listResourceInjectRegionAttribute())
+		interceptors = append(interceptors, listResourceInjectAssumeRoleAttribute())
 		// TODO: validate region in partition, needs tweaked error message
 	}
 
@@ -1040,7 +1097,7 @@ func (w *wrappedListResourceSDK) Metadata(_ context.Context, request resource.Me
 // context is run on all wrapped methods before any interceptors.
 func (w *wrappedListResourceSDK) context(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var overrideRegion string
+	var overrideAssumeRole, overrideRegion string
 
 	var isRegionOverrideEnabled bool
 	if regionSpec := w.spec.Region; !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
@@ -1059,9 +1116,16 @@ func (w *wrappedListResourceSDK) context(ctx context.Context, getAttribute getAt
 		} else {
 			overrideRegion = target.ValueString()
 		}
+
+		diags.Append(getAttribute(ctx, path.Root(names.AttrAssumeRole), &target)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		overrideAssumeRole = target.ValueString()
 	}
 
-	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion, overrideAssumeRole)
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
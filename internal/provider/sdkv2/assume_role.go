@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func resourceValidateAssumeRole() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		switch when, why := opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				return c.ValidateInContextAssumeRole(ctx)
+			}
+		}
+
+		return nil
+	})
+}
+
+func forceNewIfAssumeRoleAccountChanges() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				// Force resource replacement if the value of the top-level `assume_role` attribute changes the effective AWS account.
+				if d.Id() != "" && d.HasChange(names.AttrAssumeRole) {
+					if !d.NewValueKnown(names.AttrAssumeRole) {
+						return d.ForceNew(names.AttrAssumeRole)
+					}
+					o, n := d.GetChange(names.AttrAssumeRole)
+					if c.AccountIDForAssumeRole(o.(string)) == c.AccountIDForAssumeRole(n.(string)) {
+						return nil
+					}
+					return d.ForceNew(names.AttrAssumeRole)
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+func dataSourceValidateAssumeRole() crudInterceptor {
+	return interceptorFunc1[schemaResourceData, diag.Diagnostics](func(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+		c := opts.c
+		var diags diag.Diagnostics
+
+		switch when, why := opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case Read:
+				// As data sources have no CustomizeDiff functionality, we validate the per-resource IAM role override value here.
+				if err := c.ValidateInContextAssumeRole(ctx); err != nil {
+					return sdkdiag.AppendFromErr(diags, err)
+				}
+			}
+		}
+
+		return diags
+	})
+}
+
+func importAssumeRole() importInterceptor {
+	return interceptorFunc1[*schema.ResourceData, error](func(ctx context.Context, opts importInterceptorOptions) error {
+		d := opts.d
+
+		switch when, why := opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case Import:
+				var roleARN string
+
+				// Import ID optionally ends with "@<role-arn>".
+				if matches := regexache.MustCompile(`^(.+)@(` + inttypes.IAMRoleARNPatternNoAnchors + `)$`).FindStringSubmatch(d.Id()); len(matches) == 3 {
+					d.SetId(matches[1])
+					roleARN = matches[2]
+				} else if d.Id() == "" {
+					// Resource identity optionally includes "assume_role".
+					identity, err := d.Identity()
+					if err != nil {
+						return err
+					}
+
+					if v, ok := identity.GetOk(names.AttrAssumeRole); ok {
+						v, ok := v.(string)
+						if !ok || !regexache.MustCompile(`^`+inttypes.IAMRoleARNPatternNoAnchors+`$`).MatchString(v) {
+							return fmt.Errorf("identity attribute %q: must be an IAM role ARN, got %v", names.AttrAssumeRole, v)
+						}
+						roleARN = v
+					}
+				}
+
+				if roleARN == "" {
+					return nil
+				}
+
+				d.Set(names.AttrAssumeRole, roleARN)
+
+				// The import ID or identity was not available when the context was bootstrapped.
+				if inContext, ok := conns.FromContext(ctx); ok {
+					inContext.SetOverrideAssumeRole(roleARN)
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+func resourceImportAssumeRole() interceptorInvocation {
+	return interceptorInvocation{
+		when:        Before,
+		why:         Import,
+		interceptor: importAssumeRole(),
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// resourceDeletionGuard refuses to delete resources protected by the provider's deletion_guard.
+func resourceDeletionGuard(isTagged bool) crudInterceptor {
+	return interceptorFunc1[schemaResourceData, diag.Diagnostics](func(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+		c := opts.c
+		var diags diag.Diagnostics
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case Delete:
+				if err := checkDeletionGuard(ctx, c, d, isTagged, false); err != nil {
+					return sdkdiag.AppendFromErr(diags, err)
+				}
+			}
+		}
+
+		return diags
+	})
+}
+
+// resourceDeletionGuardReplace refuses to plan the replacement of resources protected by the provider's deletion_guard.
+// Replacement is detected from the diff computed by the resource's CustomizeDiff function,
+// i.e. changes to ForceNew attributes or attributes forced new by calls to ResourceDiff.ForceNew.
+func resourceDeletionGuardReplace(r *schema.Resource, isTagged bool) customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case After:
+			switch why {
+			case CustomizeDiff:
+				if d.Id() == "" || d.GetRawState().IsNull() {
+					return nil
+				}
+
+				s := r.SchemaMap()
+				if !requiresReplace(s, d.GetChangedKeysPrefix("")) && !forcedNew(s, d) {
+					return nil
+				}
+
+				return checkDeletionGuard(ctx, c, d, isTagged, true)
+			}
+		}
+
+		return nil
+	})
+}
+
+func checkDeletionGuard(ctx context.Context, c awsClient, d sdkv2.ResourceDiffer, isTagged, replace bool) error {
+	config := c.DeletionGuardConfig(ctx)
+	if config == nil {
+		return nil
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	typeName := inContext.TypeName()
+	var tagsAll map[string]string
+	if isTagged {
+		// tags_all holds the configured tags merged with any default_tags.
+		tagsAll = tftags.New(ctx, d.Get(names.AttrTagsAll)).Map()
+	}
+
+	if !config.Protects(typeName, tagsAll) {
+		return nil
+	}
+
+	return &conns.DeletionGuardError{
+		TypeName: typeName,
+		ID:       d.Id(),
+		Replace:  replace,
+	}
+}
+
+// requiresReplace returns whether any of the specified changed attribute keys is ForceNew in the specified schema.
+func requiresReplace(s map[string]*schema.Schema, keys []string) bool {
+	for _, key := range keys {
+		if isForceNew(s, strings.Split(key, ".")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// forcedNew returns whether any attribute has been forced new by a call to ResourceDiff.ForceNew.
+// ResourceDiff.UpdatedKeys reports both forced new keys and keys set by SetNew or SetNewComputed.
+// The latter are only allowed on Computed attributes and are not used to override configured values,
+// so a changed attribute that is not Computed, or whose value is configured, has been forced new.
+func forcedNew(s map[string]*schema.Schema, d *schema.ResourceDiff) bool {
+	config := d.GetRawConfig()
+
+	for _, key := range d.UpdatedKeys() {
+		v, ok := s[key]
+		if !ok || !d.HasChange(key) {
+			continue
+		}
+
+		if !v.Computed {
+			return true
+		}
+
+		if v.Optional && config.IsKnown() && !config.IsNull() && config.Type().HasAttribute(key) && !config.GetAttr(key).IsNull() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isForceNew(s map[string]*schema.Schema, address []string) bool {
+	v, ok := s[address[0]]
+	if !ok {
+		return false
+	}
+
+	if v.ForceNew {
+		return true
+	}
+
+	// Skip the list index or set hash to descend into a nested block.
+	if r, ok := v.Elem.(*schema.Resource); ok && len(address) > 2 {
+		return isForceNew(r.SchemaMap(), address[2:])
+	}
+
+	return false
+}
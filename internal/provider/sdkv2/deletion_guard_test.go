@@ -0,0 +1,260 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRequiresReplace(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		names.AttrDescription: {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		names.AttrName: {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"setting": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrEngine: {
+						Type:     schema.TypeString,
+						Optional: true,
+						ForceNew: true,
+					},
+					names.AttrValue: {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		keys []string
+		want bool
+	}{
+		"no changes": {},
+		"in-place update": {
+			keys: []string{names.AttrDescription},
+		},
+		"top-level ForceNew": {
+			keys: []string{names.AttrDescription, names.AttrName},
+			want: true,
+		},
+		"nested in-place update": {
+			keys: []string{"setting.0.value"},
+		},
+		"nested ForceNew": {
+			keys: []string{"setting.0.engine"},
+			want: true,
+		},
+		"nested block count": {
+			keys: []string{"setting.#"},
+		},
+		"unknown attribute": {
+			keys: []string{"tags_all.Name"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := requiresReplace(s, testCase.keys), testCase.want; got != want {
+				t.Errorf("requiresReplace = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestResourceDeletionGuardReplace(t *testing.T) {
+	t.Parallel()
+
+	const (
+		attrComputedValue = "computed_value"
+		id                = "id-1"
+	)
+
+	contextFunc := func(ctx context.Context, _ getAttributeFunc, _ getProviderMetaFunc, meta any) (context.Context, error) {
+		return conns.NewResourceContext(ctx, "Test", "test", "aws_test", "", ""), nil
+	}
+
+	// engine_version changes force replacement only when the major version changes.
+	customizeDiff := func(_ context.Context, d *schema.ResourceDiff, _ any) error {
+		if d.HasChange(names.AttrEngineVersion) {
+			if o, n := d.GetChange(names.AttrEngineVersion); o.(string)[:1] != n.(string)[:1] {
+				if err := d.ForceNew(names.AttrEngineVersion); err != nil {
+					return err
+				}
+			}
+		}
+		if d.HasChange(names.AttrDescription) {
+			if err := d.SetNewComputed(attrComputedValue); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	newResource := func() *schema.Resource {
+		r := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				attrComputedValue: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrDescription: {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				names.AttrEngineVersion: {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		}
+		r.CustomizeDiff = interceptedCustomizeDiffHandler(contextFunc, interceptorInvocations{
+			{
+				when:        After,
+				why:         CustomizeDiff,
+				interceptor: resourceDeletionGuardReplace(r, false),
+			},
+		}, customizeDiff)
+		return r
+	}
+
+	prior := map[string]string{
+		attrComputedValue:       "computed",
+		names.AttrDescription:   "old",
+		names.AttrEngineVersion: "1.0",
+		names.AttrName:          "old",
+	}
+
+	testCases := map[string]struct {
+		config   map[string]string
+		create   bool
+		guard    *conns.DeletionGuardConfig
+		expected bool
+	}{
+		"create": {
+			config: map[string]string{names.AttrName: "new"},
+			create: true,
+			guard:  &conns.DeletionGuardConfig{ResourceTypes: []string{"aws_test"}},
+		},
+		"no changes": {
+			config: map[string]string{names.AttrDescription: "old", names.AttrEngineVersion: "1.0", names.AttrName: "old"},
+			guard:  &conns.DeletionGuardConfig{ResourceTypes: []string{"aws_test"}},
+		},
+		"in-place update with SetNewComputed": {
+			config: map[string]string{names.AttrDescription: "new", names.AttrEngineVersion: "1.0", names.AttrName: "old"},
+			guard:  &conns.DeletionGuardConfig{ResourceTypes: []string{"aws_test"}},
+		},
+		"CustomizeDiff in-place update": {
+			config: map[string]string{names.AttrDescription: "old", names.AttrEngineVersion: "1.1", names.AttrName: "old"},
+			guard:  &conns.DeletionGuardConfig{ResourceTypes: []string{"aws_test"}},
+		},
+		"ForceNew attribute": {
+			config:   map[string]string{names.AttrDescription: "old", names.AttrEngineVersion: "1.0", names.AttrName: "new"},
+			guard:    &conns.DeletionGuardConfig{ResourceTypes: []string{"aws_test"}},
+			expected: true,
+		},
+		"CustomizeDiff ForceNew": {
+			config:   map[string]string{names.AttrDescription: "old", names.AttrEngineVersion: "2.0", names.AttrName: "old"},
+			guard:    &conns.DeletionGuardConfig{ResourceTypes: []string{"aws_test"}},
+			expected: true,
+		},
+		"CustomizeDiff ForceNew not protected": {
+			config: map[string]string{names.AttrDescription: "old", names.AttrEngineVersion: "2.0", names.AttrName: "old"},
+			guard:  &conns.DeletionGuardConfig{ResourceTypes: []string{"aws_other"}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			r := newResource()
+			client := deletionGuardMockClient{
+				mockClient: mockClient{
+					accountID: "123456789012",
+					region:    "us-west-2", //lintignore:AWSAT003
+				},
+				config: testCase.guard,
+			}
+
+			rawConfig := make(map[string]any)
+			configVals := make(map[string]cty.Value)
+			for k := range r.SchemaMap() {
+				configVals[k] = cty.NullVal(cty.String)
+			}
+			for k, v := range testCase.config {
+				rawConfig[k] = v
+				configVals[k] = cty.StringVal(v)
+			}
+
+			var state *terraform.InstanceState
+			if !testCase.create {
+				stateVals := map[string]cty.Value{names.AttrID: cty.StringVal(id)}
+				attributes := map[string]string{names.AttrID: id}
+				for k, v := range prior {
+					stateVals[k] = cty.StringVal(v)
+					attributes[k] = v
+				}
+				state = &terraform.InstanceState{
+					ID:         id,
+					Attributes: attributes,
+					RawConfig:  cty.ObjectVal(configVals),
+					RawState:   cty.ObjectVal(stateVals),
+				}
+			}
+
+			_, err := r.SimpleDiff(ctx, state, terraform.NewResourceConfigRaw(rawConfig), client)
+
+			if testCase.expected {
+				var target *conns.DeletionGuardError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected DeletionGuardError, got %v", err)
+				}
+				if !target.Replace {
+					t.Error("expected replacement to be reported")
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+type deletionGuardMockClient struct {
+	mockClient
+	config *conns.DeletionGuardConfig
+}
+
+func (c deletionGuardMockClient) DeletionGuardConfig(context.Context) *conns.DeletionGuardConfig {
+	return c.config
+}
@@ -16,6 +16,15 @@ func NewIdentitySchema(identitySpec inttypes.Identity) map[string]*schema.Schema
 	return identitySchema
 }
 
+// AssumeRoleAttribute returns the schema for a top-level "assume_role" identity attribute.
+// The attribute is only used to specify a per-resource IAM role when importing by identity.
+func AssumeRoleAttribute() *schema.Schema {
+	return &schema.Schema{
+		Type:              schema.TypeString,
+		OptionalForImport: true,
+	}
+}
+
 func newIdentityAttribute(attribute inttypes.IdentityAttribute) *schema.Schema {
 	attr := &schema.Schema{
 		Type: schema.TypeString,
@@ -149,12 +149,17 @@ func newIdentityInterceptor(identitySpec *inttypes.Identity) interceptorInvocati
 	return interceptor
 }
 
-func newResourceIdentity(v inttypes.Identity) *schema.ResourceIdentity {
+func newResourceIdentity(v inttypes.Identity, isRegionOverrideEnabled bool) *schema.ResourceIdentity {
 	return &schema.ResourceIdentity{
 		Version:           v.Version(),
 		IdentityUpgraders: v.SDKv2IdentityUpgraders(),
 		SchemaFunc: func() map[string]*schema.Schema {
-			return identity.NewIdentitySchema(v)
+			s := identity.NewIdentitySchema(v)
+			if isRegionOverrideEnabled {
+				// Inject a top-level "assume_role" identity attribute.
+				s[names.AttrAssumeRole] = identity.AssumeRoleAttribute()
+			}
+			return s
 		},
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/identity"
@@ -15,6 +16,8 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 func TestIdentityInterceptor(t *testing.T) {
@@ -295,6 +298,13 @@ func (c mockClient) AccountID(_ context.Context) string {
 	return c.accountID
 }
 
+func (c mockClient) AccountIDForAssumeRole(roleARN string) string {
+	if v, err := arn.Parse(roleARN); err == nil {
+		return v.AccountID
+	}
+	return c.accountID
+}
+
 func (c mockClient) Region(_ context.Context) string {
 	return c.region
 }
@@ -319,6 +329,10 @@ func (c mockClient) ServicePackage(_ context.Context, name string) conns.Service
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) ValidateInContextAssumeRole(ctx context.Context) error {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) ValidateInContextRegionInPartition(ctx context.Context) error {
 	panic("not implemented") //lintignore:R009
 }
@@ -327,6 +341,25 @@ func (c mockClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) DeletionGuardConfig(context.Context) *conns.DeletionGuardConfig {
+	return nil
+}
+
+func (c mockClient) QuotaChecksConfig(context.Context) *conns.QuotaChecksConfig {
+	return nil
+}
+
+func (c mockClient) CheckQuota(context.Context, string, func(string) (string, bool)) error {
+	return nil
+}
+
+func (c mockClient) FlushSpans(context.Context) {
+}
+
+func (c mockClient) StartSpan(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return noop.NewTracerProvider().Tracer("").Start(ctx, operation)
+}
+
 func TestIdentityIsFullyNull(t *testing.T) {
 	t.Parallel()
 
@@ -16,18 +16,27 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type awsClient interface {
 	AccountID(ctx context.Context) string
+	AccountIDForAssumeRole(roleARN string) string
 	Region(ctx context.Context) string
 	DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig
+	DeletionGuardConfig(ctx context.Context) *conns.DeletionGuardConfig
 	IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig
 	Partition(context.Context) string
+	QuotaChecksConfig(context.Context) *conns.QuotaChecksConfig
+	CheckQuota(ctx context.Context, typeName string, getAttribute func(string) (string, bool)) error
 	ServicePackage(_ context.Context, name string) conns.ServicePackage
 	TagPolicyConfig(context.Context) *tftags.TagPolicyConfig
+	ValidateInContextAssumeRole(ctx context.Context) error
 	ValidateInContextRegionInPartition(ctx context.Context) error
 	AwsConfig(context.Context) aws.Config
+	FlushSpans(ctx context.Context)
+	StartSpan(ctx context.Context, operation string) (context.Context, trace.Span)
 }
 
 // schemaResourceData is an interface that implements a subset of schema.ResourceData's public methods.
@@ -122,6 +131,26 @@ const (
 	AllCRUDOps = Create | Read | Update | Delete // Interceptor is invoked for all CRUD calls
 )
 
+// spanOperation returns the name of the traced operation.
+func (w why) spanOperation() string {
+	switch w {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	case CustomizeDiff:
+		return "CustomizeDiff"
+	case Import:
+		return "Import"
+	default:
+		return ""
+	}
+}
+
 type interceptorInvocations []interceptorInvocation
 
 func (s interceptorInvocations) why(why why) interceptorInvocations {
@@ -145,6 +174,15 @@ func interceptedCRUDHandler[F ~func(context.Context, *schema.ResourceData, any)
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 
+		ctx, span := meta.(awsClient).StartSpan(ctx, why.spanOperation())
+		defer func() {
+			if diags.HasError() {
+				span.SetStatus(codes.Error, "operation returned error diagnostics")
+			}
+			span.End()
+			meta.(awsClient).FlushSpans(ctx)
+		}()
+
 		var interceptors []crudInterceptorInvocation
 		for _, v := range interceptorInvocations.why(why) {
 			if interceptor, ok := v.interceptor.(crudInterceptor); ok {
@@ -204,14 +242,24 @@ func interceptedCRUDHandler[F ~func(context.Context, *schema.ResourceData, any)
 // interceptedCustomizeDiffHandler returns a handler that invokes the specified CustomizeDiff handler, running any interceptors.
 func interceptedCustomizeDiffHandler(bootstrapContext contextFunc, interceptorInvocations interceptorInvocations, f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	// We run CustomizeDiff interceptors even if the resource has not defined a CustomizeDiff function.
-	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
-		ctx, err := bootstrapContext(ctx, d.GetOk, nil, meta)
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) (err error) {
+		ctx, err = bootstrapContext(ctx, d.GetOk, nil, meta)
 		if err != nil {
 			return err
 		}
 
 		why := CustomizeDiff
 
+		ctx, span := meta.(awsClient).StartSpan(ctx, why.spanOperation())
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+			meta.(awsClient).FlushSpans(ctx)
+		}()
+
 		var interceptors []customizeDiffInterceptorInvocation
 		for _, v := range interceptorInvocations.why(why) {
 			if interceptor, ok := v.interceptor.(customizeDiffInterceptor); ok {
@@ -279,14 +327,24 @@ func interceptedImportHandler(bootstrapContext contextFunc, interceptorInvocatio
 		return nil
 	}
 
-	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-		ctx, err := bootstrapContext(ctx, d.GetOk, nil, meta)
+	return func(ctx context.Context, d *schema.ResourceData, meta any) (_ []*schema.ResourceData, err error) {
+		ctx, err = bootstrapContext(ctx, d.GetOk, nil, meta)
 		if err != nil {
 			return nil, err
 		}
 
 		why := Import
 
+		ctx, span := meta.(awsClient).StartSpan(ctx, why.spanOperation())
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+			meta.(awsClient).FlushSpans(ctx)
+		}()
+
 		var interceptors []importInterceptorInvocation
 		for _, v := range interceptorInvocations.why(why) {
 			if interceptor, ok := v.interceptor.(importInterceptor); ok {
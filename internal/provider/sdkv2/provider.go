@@ -11,6 +11,7 @@ import (
 	"log"
 	"maps"
 	"os"
+	"regexp"
 	"slices"
 	"strings"
 	"time"
@@ -43,8 +44,9 @@ var (
 )
 
 type sdkProvider struct {
-	provider        *schema.Provider
-	servicePackages iter.Seq2[int, conns.ServicePackage]
+	provider         *schema.Provider
+	resourceTimeouts map[string]schema.ResourceTimeout // Resources' own timeouts, before any provider-level defaults are applied.
+	servicePackages  iter.Seq2[int, conns.ServicePackage]
 }
 
 // providerMeta matches the shape of ProviderMetaSchema
@@ -76,6 +78,12 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 				},
 				"assume_role":                   assumeRoleSchema(),
 				"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+				"audit_log_file": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Path of a file to which a JSON line is appended for every AWS API call. " +
+						"Each line records the service, operation, Region, account ID, resource type, request ID, latency, retry count and any error code.",
+				},
 				"custom_ca_bundle": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -90,6 +98,39 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Configuration block with settings to default resource tags across all resources.",
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
+							"rule": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Resource tags to default across the resources matching the rule's conditions. Rules are merged in order.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"exclude_resource_types": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Resource type names or glob patterns, e.g. `aws_rds_*`, to which the rule does not apply.",
+										},
+										"regions": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "AWS Regions in which the rule applies. Defaults to all Regions.",
+										},
+										"resource_types": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Resource type names or glob patterns, e.g. `aws_rds_*`, to which the rule applies. Defaults to all resource types.",
+										},
+										"tags": {
+											Type:        schema.TypeMap,
+											Required:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Resource tags to default across the matching resources.",
+										},
+									},
+								},
+							},
 							"tags": {
 								Type:     schema.TypeMap,
 								Optional: true,
@@ -100,6 +141,63 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 						},
 					},
 				},
+				"default_timeouts": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Description: "Configuration block with default resource operation timeouts for matching resource types. " +
+						"Timeouts configured in a resource's `timeouts` block take precedence.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"create": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  "Default Create timeout. Valid time units are ns, us (or µs), ms, s, h, or m.",
+								ValidateFunc: verify.ValidDuration,
+							},
+							"delete": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  "Default Delete timeout. Valid time units are ns, us (or µs), ms, s, h, or m.",
+								ValidateFunc: verify.ValidDuration,
+							},
+							"resource_types": {
+								Type:        schema.TypeSet,
+								Required:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Resource type names or glob patterns, e.g. `aws_rds_*`, to which the default timeouts apply.",
+							},
+							"update": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  "Default Update timeout. Valid time units are ns, us (or µs), ms, s, h, or m.",
+								ValidateFunc: verify.ValidDuration,
+							},
+						},
+					},
+				},
+				"deletion_guard": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Description: "Configuration block with settings to refuse the deletion or replacement of matching resources. " +
+						"Can be overridden by setting the " + conns.DeletionGuardOverrideEnvVar + " environment variable to `true`.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"resource_types": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Resource type names or glob patterns, e.g. `aws_rds_*`, of resources to protect.",
+							},
+							"tags": {
+								Type:        schema.TypeMap,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Resources whose `tags_all` contain all of these tags are protected.",
+							},
+						},
+					},
+				},
 				"ec2_metadata_service_endpoint": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -152,6 +250,32 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 								Description: "Resource tag key prefixes to ignore across all resources. " +
 									"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 							},
+							"key_regexes": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+								Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+							},
+							"key_value": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Resource tags to ignore across all resources by key regular expression and value.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"key_regex": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsValidRegExp,
+											Description:  "Regular expression matching resource tag keys.",
+										},
+										"value": {
+											Type:        schema.TypeString,
+											Required:    true,
+											Description: "Resource tag value.",
+										},
+									},
+								},
+							},
 						},
 					},
 				},
@@ -180,6 +304,12 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The profile for API operations. If not set, the default profile\n" +
 						"created with `aws configure` will be used.",
 				},
+				"read_only": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Reject any AWS API operation that is not classified as a read, " +
+						"such as Describe*, Get*, Head* and List* operations.",
+				},
 				"region": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -213,6 +343,14 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The secret key for API operations. You can retrieve this\n" +
 						"from the 'Security & Credentials' section of the AWS console.",
 				},
+				"service_quota_checks": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: `The severity with which to report planned resource creations that would exceed AWS service quotas. ` +
+						`Valid values are "error", "warning", and "disabled". ` +
+						`When unset or "disabled", service quotas will not be checked.`,
+					ValidateFunc: validation.StringInSlice([]string{"error", "warning", "disabled"}, false),
+				},
 				"shared_config_files": {
 					Type:        schema.TypeList,
 					Optional:    true,
@@ -276,6 +414,26 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Optional:    true,
 					Description: "The capacity of the AWS SDK's token bucket rate limiter.",
 				},
+				"tracing": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Configuration block with settings to export OpenTelemetry traces of provider operations and AWS API calls.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"file": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Path of a file to which spans are appended as OTLP JSON Lines.",
+							},
+							"otlp_endpoint": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "URL of an OTLP/HTTP collector endpoint to which spans are exported.",
+							},
+						},
+					},
+				},
 				"use_dualstack_endpoint": {
 					Type:        schema.TypeBool,
 					Optional:    true,
@@ -367,6 +525,7 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		AuditLogFile:                   d.Get("audit_log_file").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -374,6 +533,7 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
+		ReadOnly:                       d.Get("read_only").(bool),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
@@ -464,6 +624,14 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.DefaultTagsConfig = expandDefaultTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("default_timeouts"); ok && len(v.([]any)) > 0 {
+		config.DefaultTimeoutsConfig = expandDefaultTimeouts(ctx, v.([]any))
+	}
+
+	if v, ok := d.GetOk("deletion_guard"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		config.DeletionGuardConfig = expandDeletionGuard(ctx, v.([]any)[0].(map[string]any))
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -504,6 +672,12 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 	}
 	config.TagPolicyConfig = tagCfg
 
+	if v, ok := d.GetOk("service_quota_checks"); ok && v.(string) != "disabled" {
+		config.QuotaChecksConfig = &conns.QuotaChecksConfig{
+			Severity: v.(string),
+		}
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -524,6 +698,10 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		}
 	}
 
+	if v, ok := d.GetOk("tracing"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		config.TracingConfig = expandTracing(ctx, v.([]any)[0].(map[string]any))
+	}
+
 	if v, ok := d.GetOk("user_agent"); ok && len(v.([]any)) > 0 {
 		config.UserAgent = useragent.FromSlice(v.([]any))
 	}
@@ -541,15 +719,44 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		return nil, diags
 	}
 
+	p.setDefaultTimeouts(ctx, config.DefaultTimeoutsConfig)
+
 	return c, diags
 }
 
+// setDefaultTimeouts applies any provider-level default operation timeouts to matching resources.
+// Terraform Plugin SDK v2 resolves a resource's timeouts at plan time from its `timeouts` configuration block and these defaults.
+// Only operations for which the resource declares a timeout are affected.
+func (p *sdkProvider) setDefaultTimeouts(_ context.Context, config *conns.DefaultTimeoutsConfig) {
+	// Always start from the resource's own timeouts so that defaults from a previous configuration don't persist.
+	for typeName, resourceTimeouts := range p.resourceTimeouts {
+		r, ok := p.provider.ResourcesMap[typeName]
+		if !ok {
+			continue
+		}
+
+		defaults := config.ResourceTimeouts(typeName)
+		timeouts := resourceTimeouts
+		if v := defaults.Create; v > 0 && timeouts.Create != nil {
+			timeouts.Create = &v
+		}
+		if v := defaults.Update; v > 0 && timeouts.Update != nil {
+			timeouts.Update = &v
+		}
+		if v := defaults.Delete; v > 0 && timeouts.Delete != nil {
+			timeouts.Delete = &v
+		}
+		r.Timeouts = &timeouts
+	}
+}
+
 // initialize is called from `New` to perform any Terraform Plugin SDK v2-style initialization.
 func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServicePackage, error) {
 	log.Printf("Initializing Terraform AWS Provider (SDKv2-style)...")
 
 	var errs []error
 	servicePackageMap := make(map[string]conns.ServicePackage)
+	p.resourceTimeouts = make(map[string]schema.ResourceTimeout)
 
 	for _, sp := range p.servicePackages {
 		servicePackageName := sp.ServicePackageName()
@@ -597,6 +804,26 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					}
 				}
 
+				if _, ok := s[names.AttrAssumeRole]; !ok {
+					// Inject a top-level "assume_role" attribute.
+					assumeRoleSchema := sdkv2.AssumeRoleOptional()
+
+					if f := r.SchemaFunc; f != nil {
+						r.SchemaFunc = func() map[string]*schema.Schema {
+							s := f()
+							s[names.AttrAssumeRole] = assumeRoleSchema
+							return s
+						}
+					} else {
+						r.Schema[names.AttrAssumeRole] = assumeRoleSchema
+					}
+				}
+
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         Read,
+					interceptor: dataSourceValidateAssumeRole(),
+				})
 				if v.IsValidateOverrideInPartition {
 					interceptors = append(interceptors, interceptorInvocation{
 						when:        Before,
@@ -621,15 +848,18 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 
 			opts := wrappedDataSourceOptions{
 				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, getProviderMeta getProviderMetaFunc, meta any) (context.Context, error) {
-					var overrideRegion string
+					var overrideAssumeRole, overrideRegion string
 
 					if isRegionOverrideEnabled && getAttribute != nil {
 						if region, ok := getAttribute(names.AttrRegion); ok {
 							overrideRegion = region.(string)
 						}
+						if roleARN, ok := getAttribute(names.AttrAssumeRole); ok {
+							overrideAssumeRole = roleARN.(string)
+						}
 					}
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, v.TypeName, overrideRegion)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, v.TypeName, overrideRegion, overrideAssumeRole)
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -714,6 +944,31 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					}
 				}
 
+				if _, ok := s[names.AttrAssumeRole]; !ok {
+					// Inject a top-level "assume_role" attribute.
+					assumeRoleSchema := sdkv2.AssumeRoleOptional()
+
+					// If the resource defines no Update handler then add a stub to fake out 'Provider.Validate'.
+					if r.UpdateWithoutTimeout == nil {
+						r.UpdateWithoutTimeout = schema.NoopContext
+					}
+
+					if f := r.SchemaFunc; f != nil {
+						r.SchemaFunc = func() map[string]*schema.Schema {
+							s := f()
+							s[names.AttrAssumeRole] = assumeRoleSchema
+							return s
+						}
+					} else {
+						r.Schema[names.AttrAssumeRole] = assumeRoleSchema
+					}
+				}
+
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: resourceValidateAssumeRole(),
+				})
 				if v.IsValidateOverrideInPartition {
 					interceptors = append(interceptors, interceptorInvocation{
 						when:        Before,
@@ -739,6 +994,13 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					why:         CustomizeDiff,
 					interceptor: forceNewIfRegionChanges(),
 				})
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: forceNewIfAssumeRoleAccountChanges(),
+				})
+				// The "@<role-arn>" suffix is stripped from the import ID before any "@<region>" suffix.
+				interceptors = append(interceptors, resourceImportAssumeRole())
 				if resource.Identity.HasInherentRegion() {
 					interceptors = append(interceptors, resourceImportRegionNoDefault())
 				} else {
@@ -764,8 +1026,25 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				})
 			}
 
+			isTagged := !tfunique.IsHandleNil(resource.Tags)
+			interceptors = append(interceptors, interceptorInvocation{
+				when:        Before,
+				why:         Delete,
+				interceptor: resourceDeletionGuard(isTagged),
+			})
+			interceptors = append(interceptors, interceptorInvocation{
+				when:        After,
+				why:         CustomizeDiff,
+				interceptor: resourceDeletionGuardReplace(r, isTagged),
+			})
+			interceptors = append(interceptors, interceptorInvocation{
+				when:        Before,
+				why:         CustomizeDiff,
+				interceptor: resourceCheckQuota(),
+			})
+
 			if len(resource.Identity.Attributes) > 0 {
-				r.Identity = newResourceIdentity(resource.Identity)
+				r.Identity = newResourceIdentity(resource.Identity, isRegionOverrideEnabled)
 
 				if resource.Identity.IsMutable {
 					r.ResourceBehavior.MutableIdentity = true
@@ -802,15 +1081,18 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
 				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, getProviderMeta getProviderMetaFunc, meta any) (context.Context, error) {
-					var overrideRegion string
+					var overrideAssumeRole, overrideRegion string
 
 					if isRegionOverrideEnabled && getAttribute != nil {
 						if region, ok := getAttribute(names.AttrRegion); ok && region != nil {
 							overrideRegion = region.(string)
 						}
+						if roleARN, ok := getAttribute(names.AttrAssumeRole); ok && roleARN != nil {
+							overrideAssumeRole = roleARN.(string)
+						}
 					}
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, resource.TypeName, overrideRegion)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, resource.TypeName, overrideRegion, overrideAssumeRole)
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -837,6 +1119,9 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 			}
 			wrapResource(r, opts)
 			p.provider.ResourcesMap[typeName] = r
+			if r.Timeouts != nil {
+				p.resourceTimeouts[typeName] = *r.Timeouts
+			}
 		}
 	}
 
@@ -858,6 +1143,10 @@ func (p *sdkProvider) validateResourceSchemas(ctx context.Context) error {
 					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s data source", names.AttrRegion, typeName))
 					continue
 				}
+				if _, ok := s[names.AttrAssumeRole]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s data source", names.AttrAssumeRole, typeName))
+					continue
+				}
 			}
 
 			if !tfunique.IsHandleNil(v.Tags) {
@@ -885,6 +1174,10 @@ func (p *sdkProvider) validateResourceSchemas(ctx context.Context) error {
 					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s resource", names.AttrRegion, typeName))
 					continue
 				}
+				if _, ok := s[names.AttrAssumeRole]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s resource", names.AttrAssumeRole, typeName))
+					continue
+				}
 			}
 
 			if !tfunique.IsHandleNil(resource.Tags) {
@@ -1171,17 +1464,102 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 		maps.Copy(tags, cfgTags)
 	}
 
-	if len(tags) > 0 {
-		return &tftags.DefaultConfig{
-			Tags: tftags.New(ctx, tags),
+	var rules []tftags.DefaultRule
+	if v, ok := tfMap["rule"].([]any); ok {
+		rules = expandDefaultTagsRules(ctx, v)
+	}
+
+	if len(tags) > 0 || len(rules) > 0 {
+		defaultConfig := &tftags.DefaultConfig{
+			Rules: rules,
 		}
+		if len(tags) > 0 {
+			defaultConfig.Tags = tftags.New(ctx, tags)
+		}
+
+		return defaultConfig
 	}
 
 	return nil
 }
 
+func expandDefaultTagsRules(ctx context.Context, tfList []any) []tftags.DefaultRule {
+	var rules []tftags.DefaultRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		var rule tftags.DefaultRule
+
+		if v, ok := tfMap["tags"].(map[string]any); ok {
+			rule.Tags = tftags.New(ctx, v)
+		}
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok {
+			rule.ResourceTypes = flex.ExpandStringValueSet(v)
+		}
+		if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok {
+			rule.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+		if v, ok := tfMap["regions"].(*schema.Set); ok {
+			rule.Regions = flex.ExpandStringValueSet(v)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func expandDefaultTimeouts(_ context.Context, tfList []any) *conns.DefaultTimeoutsConfig {
+	defaultTimeoutsConfig := &conns.DefaultTimeoutsConfig{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		var rule conns.DefaultTimeoutsRule
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok {
+			rule.ResourceTypes = flex.ExpandStringValueSet(v)
+		}
+		if v, ok := tfMap["create"].(string); ok && v != "" {
+			rule.Create, _ = time.ParseDuration(v)
+		}
+		if v, ok := tfMap["update"].(string); ok && v != "" {
+			rule.Update, _ = time.ParseDuration(v)
+		}
+		if v, ok := tfMap["delete"].(string); ok && v != "" {
+			rule.Delete, _ = time.ParseDuration(v)
+		}
+
+		defaultTimeoutsConfig.Rules = append(defaultTimeoutsConfig.Rules, rule)
+	}
+
+	return defaultTimeoutsConfig
+}
+
+func expandDeletionGuard(_ context.Context, tfMap map[string]any) *conns.DeletionGuardConfig {
+	deletionGuardConfig := &conns.DeletionGuardConfig{}
+
+	if v, ok := tfMap["resource_types"].(*schema.Set); ok {
+		deletionGuardConfig.ResourceTypes = flex.ExpandStringValueSet(v)
+	}
+	if v, ok := tfMap["tags"].(map[string]any); ok {
+		deletionGuardConfig.Tags = flex.ExpandStringValueMap(v)
+	}
+
+	return deletionGuardConfig
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []any
+	var keyRegexes []*regexp.Regexp
+	var keyValues []tftags.IgnoreKeyValue
 
 	if tfMap != nil {
 		if v, ok := tfMap["keys"].(*schema.Set); ok {
@@ -1190,6 +1568,21 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 		if v, ok := tfMap["key_prefixes"].(*schema.Set); ok {
 			keyPrefixes = v.List()
 		}
+		if v, ok := tfMap["key_regexes"].(*schema.Set); ok {
+			for _, v := range flex.ExpandStringValueSet(v) {
+				keyRegexes = append(keyRegexes, regexache.MustCompile(v))
+			}
+		}
+		if v, ok := tfMap["key_value"].([]any); ok {
+			for _, tfMapRaw := range v {
+				if tfMap, ok := tfMapRaw.(map[string]any); ok {
+					keyValues = append(keyValues, tftags.IgnoreKeyValue{
+						KeyRegex: regexache.MustCompile(tfMap["key_regex"].(string)),
+						Value:    tfMap["value"].(string),
+					})
+				}
+			}
+		}
 	}
 
 	if v := os.Getenv(tftags.IgnoreTagsKeysEnvVar); v != "" {
@@ -1213,11 +1606,14 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 	// - Return nil when no keys or prefixes are set
 	// - For a non-nil return, `keys` or `key_prefixes` should be
 	//   nil if empty (versus a zero-value `KeyValueTags` struct)
-	if len(keys) == 0 && len(keyPrefixes) == 0 {
+	if len(keys) == 0 && len(keyPrefixes) == 0 && len(keyRegexes) == 0 && len(keyValues) == 0 {
 		return nil
 	}
 
-	ignoreConfig := &tftags.IgnoreConfig{}
+	ignoreConfig := &tftags.IgnoreConfig{
+		KeyRegexes: keyRegexes,
+		KeyValues:  keyValues,
+	}
 	if len(keys) > 0 {
 		ignoreConfig.Keys = tftags.New(ctx, keys)
 	}
@@ -1228,6 +1624,23 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 	return ignoreConfig
 }
 
+func expandTracing(_ context.Context, tfMap map[string]any) *conns.TracingConfig {
+	tracingConfig := &conns.TracingConfig{}
+
+	if v, ok := tfMap["file"].(string); ok {
+		tracingConfig.File = v
+	}
+	if v, ok := tfMap["otlp_endpoint"].(string); ok {
+		tracingConfig.OTLPEndpoint = v
+	}
+
+	if tracingConfig.File == "" && tracingConfig.OTLPEndpoint == "" {
+		return nil
+	}
+
+	return tracingConfig
+}
+
 func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyConfig, diag.Diagnostics) {
 	envSeverity := os.Getenv(tftags.TagPolicyComplianceEnvVar)
 	switch {
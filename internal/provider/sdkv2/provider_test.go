@@ -7,11 +7,13 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -440,3 +442,105 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestSetDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	const typeName = "aws_test"
+	r := &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}
+	p := &sdkProvider{
+		provider: &schema.Provider{
+			ResourcesMap: map[string]*schema.Resource{
+				typeName: r,
+			},
+		},
+		resourceTimeouts: map[string]schema.ResourceTimeout{
+			typeName: *r.Timeouts,
+		},
+	}
+
+	durationOrNil := func(v *time.Duration) any {
+		if v == nil {
+			return nil
+		}
+		return *v
+	}
+
+	// Each configuration is applied in turn to the same provider, as when the provider is configured more than once.
+	testcases := []struct {
+		name           string
+		config         *conns.DefaultTimeoutsConfig
+		expectedCreate any
+		expectedUpdate any
+		expectedDelete any
+	}{
+		{
+			name: "create default",
+			config: &conns.DefaultTimeoutsConfig{
+				Rules: []conns.DefaultTimeoutsRule{
+					{
+						ResourceTypes:    []string{"aws_*"},
+						ResourceTimeouts: conns.ResourceTimeouts{Create: time.Hour, Update: time.Hour},
+					},
+				},
+			},
+			expectedCreate: time.Hour,
+			expectedDelete: 20 * time.Minute,
+		},
+		{
+			name: "delete default",
+			config: &conns.DefaultTimeoutsConfig{
+				Rules: []conns.DefaultTimeoutsRule{
+					{
+						ResourceTypes:    []string{typeName},
+						ResourceTimeouts: conns.ResourceTimeouts{Delete: 2 * time.Hour},
+					},
+				},
+			},
+			expectedCreate: 10 * time.Minute,
+			expectedDelete: 2 * time.Hour,
+		},
+		{
+			name: "other resource type",
+			config: &conns.DefaultTimeoutsConfig{
+				Rules: []conns.DefaultTimeoutsRule{
+					{
+						ResourceTypes:    []string{"aws_other"},
+						ResourceTimeouts: conns.ResourceTimeouts{Create: time.Hour},
+					},
+				},
+			},
+			expectedCreate: 10 * time.Minute,
+			expectedDelete: 20 * time.Minute,
+		},
+		{
+			name:           "no defaults",
+			expectedCreate: 10 * time.Minute,
+			expectedDelete: 20 * time.Minute,
+		},
+	}
+
+	for _, testcase := range testcases {
+		p.setDefaultTimeouts(ctx, testcase.config)
+
+		if got, want := durationOrNil(r.Timeouts.Create), testcase.expectedCreate; got != want {
+			t.Errorf("%s: Create timeout = %v, want %v", testcase.name, got, want)
+		}
+		if got, want := durationOrNil(r.Timeouts.Update), testcase.expectedUpdate; got != want {
+			t.Errorf("%s: Update timeout = %v, want %v", testcase.name, got, want)
+		}
+		if got, want := durationOrNil(r.Timeouts.Delete), testcase.expectedDelete; got != want {
+			t.Errorf("%s: Delete timeout = %v, want %v", testcase.name, got, want)
+		}
+	}
+
+	if got, want := *p.resourceTimeouts[typeName].Create, 10*time.Minute; got != want {
+		t.Errorf("saved Create timeout = %v, want %v", got, want)
+	}
+}
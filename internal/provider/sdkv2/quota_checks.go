@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// resourceCheckQuota checks planned resource creations against any applicable AWS service quota.
+// CustomizeDiff can only return an error, so with "warning" severity exceeded quotas are logged and not shown in the plan.
+func resourceCheckQuota() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		config := c.QuotaChecksConfig(ctx)
+		if config == nil {
+			return nil
+		}
+
+		inContext, ok := conns.FromContext(ctx)
+		if !ok {
+			return nil
+		}
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				if !d.GetRawState().IsNull() {
+					return nil
+				}
+
+				plan := d.GetRawPlan()
+				getAttribute := func(name string) (string, bool) {
+					if !plan.Type().IsObjectType() || !plan.Type().HasAttribute(name) {
+						return "", false
+					}
+					v := plan.GetAttr(name)
+					if !v.IsKnown() || v.IsNull() || !v.Type().Equals(cty.String) {
+						return "", false
+					}
+					return v.AsString(), true
+				}
+
+				if err := c.CheckQuota(ctx, inContext.TypeName(), getAttribute); err != nil {
+					switch config.Severity {
+					case "warning":
+						tflog.Warn(ctx, "Service Quota Check", map[string]any{
+							"resource_type": inContext.TypeName(),
+							"detail":        err.Error(),
+						})
+					default:
+						return err
+					}
+				}
+			}
+		}
+
+		return nil
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"unique"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -75,6 +76,9 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	})
 
 	conn := &conns.AWSClient{}
+	conns.SetAWSConfig(conn, &aws.Config{
+		Region: "us-west-2", //lintignore:AWSAT003
+	})
 	conn.SetServicePackages(ctx, map[string]conns.ServicePackage{
 		"Test": &mockService{},
 	})
@@ -86,7 +90,7 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}))
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
-		ctx = conns.NewResourceContext(ctx, "Test", "test", "aws_test", "")
+		ctx = conns.NewResourceContext(ctx, "Test", "test", "aws_test", "", "")
 		if v, ok := meta.(*conns.AWSClient); ok {
 			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx), v.TagPolicyConfig(ctx))
 		}
@@ -14,6 +14,9 @@ import (
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
 )
 
@@ -70,7 +73,25 @@ type StateChangeConf = StateChangeConfOf[any, string]
 //
 // When VCR testing is enabled in replay mode, the DelayFunc is overridden to
 // allow interactions to be replayed with no delay between state change refreshes.
+//
+// When tracing is enabled, the wait and each refresh are recorded as spans.
 func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T, error) {
+	ctx, span := tracer(ctx).Start(ctx, "wait", trace.WithAttributes(
+		attribute.StringSlice("aws.waiter.pending", tfslices.Strings(conf.Pending)),
+		attribute.StringSlice("aws.waiter.target", tfslices.Strings(conf.Target)),
+	))
+	defer span.End()
+
+	t, err := conf.waitForState(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return t, err
+}
+
+func (conf *StateChangeConfOf[T, S]) waitForState(ctx context.Context) (T, error) {
 	// Set a default for times to check for not found.
 	if conf.NotFoundChecks == 0 {
 		conf.NotFoundChecks = 20
@@ -182,5 +203,22 @@ func (conf *StateChangeConfOf[T, S]) refreshWithTimeout(ctx context.Context, tim
 	// Set a deadline on the context here to maintain compatibility with the Plugin SDKv2 implementation.
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	return conf.Refresh(ctx)
+
+	ctx, span := tracer(ctx).Start(ctx, "poll")
+	defer span.End()
+
+	t, state, err := conf.Refresh(ctx)
+	span.SetAttributes(attribute.String("aws.waiter.state", string(state)))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return t, state, err
+}
+
+// tracer returns the OpenTelemetry tracer of any span in context.
+// Without a span (e.g. tracing is not enabled) a no-op tracer is returned.
+func tracer(ctx context.Context) trace.Tracer {
+	return trace.SpanFromContext(ctx).TracerProvider().Tracer(conns.TracerName)
 }
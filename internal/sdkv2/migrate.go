@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// migrationTypeName is the resource type name used when comparing schemas.
+const migrationTypeName = "aws_migration"
+
+// DiffResourceSchemaWithFramework returns the differences between a Plugin SDK v2 resource's schema
+// and the schema of the Plugin Framework resource it is being migrated to, as seen by Terraform.
+// An empty result means that existing configurations and state remain valid after the migration.
+func DiffResourceSchemaWithFramework(ctx context.Context, sdkResource *schema.Resource, fwResource resource.Resource) ([]string, error) {
+	sdkServer := schema.NewGRPCProviderServer(&schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			migrationTypeName: sdkResource,
+		},
+	})
+	sdkSchema, err := resourceSchemaFromServer(ctx, sdkServer)
+	if err != nil {
+		return nil, fmt.Errorf("Plugin SDK v2 resource schema: %w", err)
+	}
+
+	fwServer := providerserver.NewProtocol5(&migrationProvider{resource: fwResource})()
+	fwSchema, err := resourceSchemaFromServer(ctx, fwServer)
+	if err != nil {
+		return nil, fmt.Errorf("Plugin Framework resource schema: %w", err)
+	}
+
+	var diffs []string
+	if sdkSchema.Version != fwSchema.Version {
+		diffs = append(diffs, fmt.Sprintf("schema version: %d (SDKv2) != %d (Framework)", sdkSchema.Version, fwSchema.Version))
+	}
+	diffs = append(diffs, diffSchemaBlocks(nil, sdkResource.SchemaMap(), sdkSchema.Block, fwSchema.Block)...)
+
+	return diffs, nil
+}
+
+func resourceSchemaFromServer(ctx context.Context, server tfprotov5.ProviderServer) (*tfprotov5.Schema, error) {
+	response, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range response.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			return nil, fmt.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	schema, ok := response.ResourceSchemas[migrationTypeName]
+	if !ok {
+		return nil, fmt.Errorf("resource type %s not found", migrationTypeName)
+	}
+
+	return schema, nil
+}
+
+func diffSchemaBlocks(path []string, sdkSchemaMap map[string]*schema.Schema, sdkBlock, fwBlock *tfprotov5.SchemaBlock) []string {
+	var diffs []string
+
+	sdkAttributes := make(map[string]*tfprotov5.SchemaAttribute)
+	for _, v := range sdkBlock.Attributes {
+		sdkAttributes[v.Name] = v
+	}
+	fwAttributes := make(map[string]*tfprotov5.SchemaAttribute)
+	for _, v := range fwBlock.Attributes {
+		fwAttributes[v.Name] = v
+	}
+
+	for _, name := range sortedKeys(sdkAttributes, fwAttributes) {
+		attributePath := strings.Join(append(slices.Clone(path), name), ".")
+		sdkAttribute, fwAttribute := sdkAttributes[name], fwAttributes[name]
+
+		switch {
+		case fwAttribute == nil:
+			diffs = append(diffs, fmt.Sprintf("%s: attribute only in SDKv2 schema", attributePath))
+		case sdkAttribute == nil:
+			diffs = append(diffs, fmt.Sprintf("%s: attribute only in Framework schema", attributePath))
+		default:
+			if sdkType, fwType := sdkAttribute.ValueType(), fwAttribute.ValueType(); !sdkType.Equal(fwType) {
+				diffs = append(diffs, fmt.Sprintf("%s: type %s (SDKv2) != %s (Framework)", attributePath, sdkType, fwType))
+			}
+			if sdkAttribute.Required != fwAttribute.Required {
+				diffs = append(diffs, fmt.Sprintf("%s: Required %t (SDKv2) != %t (Framework)", attributePath, sdkAttribute.Required, fwAttribute.Required))
+			}
+			// Plugin SDK v2 makes an implicit top-level "id" attribute Optional.
+			if sdkAttribute.Optional != fwAttribute.Optional && (len(path) > 0 || name != names.AttrID) {
+				diffs = append(diffs, fmt.Sprintf("%s: Optional %t (SDKv2) != %t (Framework)", attributePath, sdkAttribute.Optional, fwAttribute.Optional))
+			}
+			// Plugin Framework attributes with a default value must be Computed.
+			if sdkAttribute.Computed != fwAttribute.Computed && (sdkSchemaMap[name] == nil || sdkSchemaMap[name].Default == nil) {
+				diffs = append(diffs, fmt.Sprintf("%s: Computed %t (SDKv2) != %t (Framework)", attributePath, sdkAttribute.Computed, fwAttribute.Computed))
+			}
+			if sdkAttribute.Sensitive != fwAttribute.Sensitive {
+				diffs = append(diffs, fmt.Sprintf("%s: Sensitive %t (SDKv2) != %t (Framework)", attributePath, sdkAttribute.Sensitive, fwAttribute.Sensitive))
+			}
+		}
+	}
+
+	sdkBlocks := make(map[string]*tfprotov5.SchemaNestedBlock)
+	for _, v := range sdkBlock.BlockTypes {
+		sdkBlocks[v.TypeName] = v
+	}
+	fwBlocks := make(map[string]*tfprotov5.SchemaNestedBlock)
+	for _, v := range fwBlock.BlockTypes {
+		fwBlocks[v.TypeName] = v
+	}
+
+	for _, name := range sortedKeys(sdkBlocks, fwBlocks) {
+		blockPath := append(slices.Clone(path), name)
+		sdkNestedBlock, fwNestedBlock := sdkBlocks[name], fwBlocks[name]
+
+		switch {
+		case fwNestedBlock == nil:
+			diffs = append(diffs, fmt.Sprintf("%s: block only in SDKv2 schema", strings.Join(blockPath, ".")))
+		case sdkNestedBlock == nil:
+			diffs = append(diffs, fmt.Sprintf("%s: block only in Framework schema", strings.Join(blockPath, ".")))
+		default:
+			if sdkNestedBlock.Nesting != fwNestedBlock.Nesting {
+				diffs = append(diffs, fmt.Sprintf("%s: nesting %s (SDKv2) != %s (Framework)", strings.Join(blockPath, "."), sdkNestedBlock.Nesting, fwNestedBlock.Nesting))
+			}
+			var nestedSchemaMap map[string]*schema.Schema
+			if v, ok := sdkSchemaMap[name]; ok {
+				if v, ok := v.Elem.(*schema.Resource); ok {
+					nestedSchemaMap = v.SchemaMap()
+				}
+			}
+			diffs = append(diffs, diffSchemaBlocks(blockPath, nestedSchemaMap, sdkNestedBlock.Block, fwNestedBlock.Block)...)
+		}
+	}
+
+	return diffs
+}
+
+func sortedKeys[V any](m1, m2 map[string]V) []string {
+	keys := make([]string, 0, len(m1)+len(m2))
+	for k := range m1 {
+		keys = append(keys, k)
+	}
+	for k := range m2 {
+		if _, ok := m1[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// migrationProvider is a minimal Plugin Framework provider serving a single resource.
+type migrationProvider struct {
+	resource resource.Resource
+}
+
+func (p *migrationProvider) Metadata(ctx context.Context, request provider.MetadataRequest, response *provider.MetadataResponse) {
+	response.TypeName = "aws"
+}
+
+func (p *migrationProvider) Schema(ctx context.Context, request provider.SchemaRequest, response *provider.SchemaResponse) {
+}
+
+func (p *migrationProvider) Configure(ctx context.Context, request provider.ConfigureRequest, response *provider.ConfigureResponse) {
+}
+
+func (p *migrationProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *migrationProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		func() resource.Resource {
+			return &migrationResource{Resource: p.resource}
+		},
+	}
+}
+
+// migrationResource overrides the wrapped resource's type name.
+type migrationResource struct {
+	resource.Resource
+}
+
+func (r *migrationResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = migrationTypeName
+}
@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type testMigrationResource struct {
+	schema fwschema.Schema
+}
+
+func (r *testMigrationResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+}
+
+func (r *testMigrationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = r.schema
+}
+
+func (r *testMigrationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+}
+
+func (r *testMigrationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+}
+
+func (r *testMigrationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+}
+
+func (r *testMigrationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+}
+
+func TestDiffResourceSchemaWithFramework(t *testing.T) {
+	t.Parallel()
+
+	sdkResource := &schema.Resource{
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"values": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		schema fwschema.Schema
+		want   []string
+	}{
+		"identical": {
+			schema: fwschema.Schema{
+				Version: 1,
+				Attributes: map[string]fwschema.Attribute{
+					names.AttrID: fwschema.StringAttribute{
+						Computed: true,
+					},
+					names.AttrName: fwschema.StringAttribute{
+						Required: true,
+					},
+					"values": fwschema.SetAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+				Blocks: map[string]fwschema.Block{
+					"config": fwschema.ListNestedBlock{
+						NestedObject: fwschema.NestedBlockObject{
+							Attributes: map[string]fwschema.Attribute{
+								"enabled": fwschema.BoolAttribute{
+									Optional: true,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"different": {
+			schema: fwschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					names.AttrID: fwschema.StringAttribute{
+						Computed: true,
+					},
+					names.AttrName: fwschema.StringAttribute{
+						Optional: true,
+					},
+					"values": fwschema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					names.AttrARN: fwschema.StringAttribute{
+						Computed: true,
+					},
+				},
+				Blocks: map[string]fwschema.Block{
+					"config": fwschema.SetNestedBlock{
+						NestedObject: fwschema.NestedBlockObject{
+							Attributes: map[string]fwschema.Attribute{
+								"enabled": fwschema.BoolAttribute{
+									Optional:  true,
+									Computed:  true,
+									Sensitive: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []string{
+				"schema version: 1 (SDKv2) != 0 (Framework)",
+				"arn: attribute only in Framework schema",
+				"name: Required true (SDKv2) != false (Framework)",
+				"name: Optional false (SDKv2) != true (Framework)",
+				"values: type tftypes.Set[tftypes.String] (SDKv2) != tftypes.List[tftypes.String] (Framework)",
+				"config: nesting LIST (SDKv2) != SET (Framework)",
+				"config.enabled: Sensitive false (SDKv2) != true (Framework)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := DiffResourceSchemaWithFramework(context.Background(), sdkResource, &testMigrationResource{schema: testCase.schema})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
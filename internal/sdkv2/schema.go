@@ -6,8 +6,10 @@ package sdkv2
 import (
 	"sync"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -164,6 +166,16 @@ func jsonDocumentSchemaRequiredForceNewFunc(diffSuppressFunc schema.SchemaDiffSu
 	}
 }
 
+// AssumeRoleOptional returns the standard schema for an optional per-resource IAM role ARN.
+var AssumeRoleOptional = sync.OnceValue(func() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(regexache.MustCompile(`^`+inttypes.IAMRoleARNPatternNoAnchors+`$`), "must be an IAM role ARN"),
+		Description:  names.ResourceTopLevelAssumeRoleAttributeDescription,
+	}
+})
+
 // RegionOptionalComputed returns the standard schema for an optional, computed AWS Region.
 var RegionOptionalComputed = sync.OnceValue(func() *schema.Schema {
 	return &schema.Schema{
@@ -51,6 +51,7 @@ func TestAccACMPCACertificateAuthorityCertificate_Identity_basic(t *testing.T) {
 					statecheck.CompareValuePairs(resourceName, tfjsonpath.New(names.AttrID), resourceName, tfjsonpath.New("certificate_authority_arn"), compare.ValuesSame()),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:        knownvalue.Null(),
 						"certificate_authority_arn": knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("certificate_authority_arn")),
@@ -134,6 +135,7 @@ func TestAccACMPCACertificateAuthorityCertificate_Identity_regionOverride(t *tes
 					statecheck.CompareValuePairs(resourceName, tfjsonpath.New(names.AttrID), resourceName, tfjsonpath.New("certificate_authority_arn"), compare.ValuesSame()),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:        knownvalue.Null(),
 						"certificate_authority_arn": knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("certificate_authority_arn")),
@@ -298,6 +300,7 @@ func TestAccACMPCACertificateAuthorityCertificate_Identity_ExistingResource_basi
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:        knownvalue.Null(),
 						"certificate_authority_arn": knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("certificate_authority_arn")),
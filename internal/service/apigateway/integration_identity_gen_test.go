@@ -51,6 +51,7 @@ func TestAccAPIGatewayIntegration_Identity_basic(t *testing.T) {
 					tfstatecheck.ExpectAttributeFormat(resourceName, tfjsonpath.New(names.AttrID), "agi-{rest_api_id}-{resource_id}-{http_method}"),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole: knownvalue.Null(),
 						names.AttrAccountID:  tfknownvalue.AccountID(),
 						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
 						"rest_api_id":        knownvalue.NotNull(),
@@ -144,6 +145,7 @@ func TestAccAPIGatewayIntegration_Identity_regionOverride(t *testing.T) {
 					tfstatecheck.ExpectAttributeFormat(resourceName, tfjsonpath.New(names.AttrID), "agi-{rest_api_id}-{resource_id}-{http_method}"),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole: knownvalue.Null(),
 						names.AttrAccountID:  tfknownvalue.AccountID(),
 						names.AttrRegion:     knownvalue.StringExact(acctest.AlternateRegion()),
 						"rest_api_id":        knownvalue.NotNull(),
@@ -261,6 +263,7 @@ func TestAccAPIGatewayIntegration_Identity_ExistingResource_basic(t *testing.T)
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole: knownvalue.Null(),
 						names.AttrAccountID:  tfknownvalue.AccountID(),
 						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
 						"rest_api_id":        knownvalue.NotNull(),
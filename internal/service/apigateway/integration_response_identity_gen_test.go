@@ -51,6 +51,7 @@ func TestAccAPIGatewayIntegrationResponse_Identity_basic(t *testing.T) {
 					tfstatecheck.ExpectAttributeFormat(resourceName, tfjsonpath.New(names.AttrID), "agir-{rest_api_id}-{resource_id}-{http_method}-{status_code}"),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole: knownvalue.Null(),
 						names.AttrAccountID:  tfknownvalue.AccountID(),
 						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
 						"rest_api_id":        knownvalue.NotNull(),
@@ -148,6 +149,7 @@ func TestAccAPIGatewayIntegrationResponse_Identity_regionOverride(t *testing.T)
 					tfstatecheck.ExpectAttributeFormat(resourceName, tfjsonpath.New(names.AttrID), "agir-{rest_api_id}-{resource_id}-{http_method}-{status_code}"),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole: knownvalue.Null(),
 						names.AttrAccountID:  tfknownvalue.AccountID(),
 						names.AttrRegion:     knownvalue.StringExact(acctest.AlternateRegion()),
 						"rest_api_id":        knownvalue.NotNull(),
@@ -269,6 +271,7 @@ func TestAccAPIGatewayIntegrationResponse_Identity_ExistingResource_basic(t *tes
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole: knownvalue.Null(),
 						names.AttrAccountID:  tfknownvalue.AccountID(),
 						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
 						"rest_api_id":        knownvalue.NotNull(),
@@ -51,6 +51,7 @@ func TestAccAPIGatewayMethod_Identity_basic(t *testing.T) {
 					tfstatecheck.ExpectAttributeFormat(resourceName, tfjsonpath.New(names.AttrID), "agm-{rest_api_id}-{resource_id}-{http_method}"),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole: knownvalue.Null(),
 						names.AttrAccountID:  tfknownvalue.AccountID(),
 						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
 						"rest_api_id":        knownvalue.NotNull(),
@@ -147,6 +148,7 @@ func TestAccAPIGatewayMethod_Identity_regionOverride(t *testing.T) {
 					tfstatecheck.ExpectAttributeFormat(resourceName, tfjsonpath.New(names.AttrID), "agm-{rest_api_id}-{resource_id}-{http_method}"),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole: knownvalue.Null(),
 						names.AttrAccountID:  tfknownvalue.AccountID(),
 						names.AttrRegion:     knownvalue.StringExact(acctest.AlternateRegion()),
 						"rest_api_id":        knownvalue.NotNull(),
@@ -267,6 +269,7 @@ func TestAccAPIGatewayMethod_Identity_ExistingResource_basic(t *testing.T) {
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole: knownvalue.Null(),
 						names.AttrAccountID:  tfknownvalue.AccountID(),
 						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
 						"rest_api_id":        knownvalue.NotNull(),
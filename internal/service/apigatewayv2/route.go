@@ -333,7 +333,7 @@ func resourceRouteImport(ctx context.Context, d *schema.ResourceData, meta any)
 	// Region may be overridden in the import block.
 	// Ensure the appropriate value is in context before initializing the client.
 	if v, ok := d.GetOk(names.AttrRegion); ok {
-		ctx = conns.NewResourceContext(ctx, names.APIGatewayV2, "aws_apigatewayv2_route", "Route", v.(string))
+		ctx = conns.NewResourceContext(ctx, names.APIGatewayV2, "aws_apigatewayv2_route", "Route", v.(string), d.Get(names.AttrAssumeRole).(string))
 	}
 	conn := meta.(*conns.AWSClient).APIGatewayV2Client(ctx)
 
@@ -0,0 +1,291 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_athena_start_query_execution, name="Start Query Execution")
+func newStartQueryExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startQueryExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startQueryExecutionAction)(nil)
+)
+
+type startQueryExecutionAction struct {
+	framework.ActionWithModel[startQueryExecutionActionModel]
+}
+
+type startQueryExecutionActionModel struct {
+	framework.WithRegionModel
+	Catalog             types.String         `tfsdk:"catalog"`
+	Database            types.String         `tfsdk:"database"`
+	ExecutionParameters fwtypes.ListOfString `tfsdk:"execution_parameters"`
+	OutputLocation      types.String         `tfsdk:"output_location"`
+	QueryString         types.String         `tfsdk:"query_string"`
+	ReportStatistics    types.Bool           `tfsdk:"report_statistics"`
+	Timeout             types.Int64          `tfsdk:"timeout"`
+	WorkGroup           types.String         `tfsdk:"work_group"`
+}
+
+func (a *startQueryExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an Amazon Athena query. This action is synchronous and waits for the query to succeed.",
+		Attributes: map[string]schema.Attribute{
+			"catalog": schema.StringAttribute{
+				Description: "Name of the data catalog used in the query execution",
+				Optional:    true,
+			},
+			names.AttrDatabase: schema.StringAttribute{
+				Description: "Name of the database used in the query execution",
+				Optional:    true,
+			},
+			"execution_parameters": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Description: "Values for the parameters in the query, in the order in which the parameters occur",
+				Optional:    true,
+			},
+			"output_location": schema.StringAttribute{
+				Description: "Amazon S3 location where query results are stored, e.g. s3://path/to/query/bucket/",
+				Optional:    true,
+			},
+			"query_string": schema.StringAttribute{
+				Description: "SQL query statement to run",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 262144),
+				},
+			},
+			"report_statistics": schema.BoolAttribute{
+				Description: "Whether to report the query's output location and output row count once it succeeds",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the query to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+				},
+			},
+			"work_group": schema.StringAttribute{
+				Description: "Name of the workgroup in which the query is run",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *startQueryExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startQueryExecutionActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().AthenaClient(ctx)
+
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	tflog.Info(ctx, "Starting Athena start query execution action", map[string]any{
+		"query_length":    len(config.QueryString.ValueString()),
+		"work_group":      config.WorkGroup.ValueString(),
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting Athena query execution...")
+
+	input := athena.StartQueryExecutionInput{
+		ExecutionParameters: fwflex.ExpandFrameworkStringValueList(ctx, config.ExecutionParameters),
+		QueryString:         fwflex.StringFromFramework(ctx, config.QueryString),
+		WorkGroup:           fwflex.StringFromFramework(ctx, config.WorkGroup),
+	}
+
+	if !config.Catalog.IsNull() || !config.Database.IsNull() {
+		input.QueryExecutionContext = &awstypes.QueryExecutionContext{
+			Catalog:  fwflex.StringFromFramework(ctx, config.Catalog),
+			Database: fwflex.StringFromFramework(ctx, config.Database),
+		}
+	}
+
+	if !config.OutputLocation.IsNull() {
+		input.ResultConfiguration = &awstypes.ResultConfiguration{
+			OutputLocation: fwflex.StringFromFramework(ctx, config.OutputLocation),
+		}
+	}
+
+	output, err := conn.StartQueryExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Query Execution",
+			fmt.Sprintf("Could not start Athena query execution: %s", err),
+		)
+		return
+	}
+
+	queryExecutionID := aws.ToString(output.QueryExecutionId)
+	cb(ctx, "Query execution %s started, waiting for completion...", queryExecutionID)
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.QueryExecution], error) {
+		execution, ferr := findQueryExecutionByID(ctx, conn, queryExecutionID)
+		if ferr != nil {
+			return actionwait.FetchResult[*awstypes.QueryExecution]{}, fmt.Errorf("getting query execution: %w", ferr)
+		}
+		return actionwait.FetchResult[*awstypes.QueryExecution]{Status: actionwait.Status(execution.Status.State), Value: execution}, nil
+	}, actionwait.Options[*awstypes.QueryExecution]{
+		Timeout:          timeout,
+		Interval:         actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.QueryExecutionStateSucceeded)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateQueued),
+			actionwait.Status(awstypes.QueryExecutionStateRunning),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateCancelled),
+			actionwait.Status(awstypes.QueryExecutionStateFailed),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Query execution %s currently in state: %s", queryExecutionID, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Query Execution",
+				fmt.Sprintf("Athena query execution %s did not complete within %s", queryExecutionID, timeout),
+			)
+		} else if errors.As(err, &failureErr) {
+			detail := fmt.Sprintf("Athena query execution %s completed with status: %s", queryExecutionID, failureErr.Status)
+			if v := queryExecutionFailureReason(result.Value); v != "" {
+				detail += "\n\n" + v
+			}
+			resp.Diagnostics.AddError("Query Execution Failed", detail)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected Query Execution Status", err.Error())
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Query Execution",
+				fmt.Sprintf("Error while waiting for Athena query execution %s: %s", queryExecutionID, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Query execution %s completed successfully", queryExecutionID)
+
+	if fwflex.BoolValueFromFramework(ctx, config.ReportStatistics) {
+		if v := result.Value.ResultConfiguration; v != nil && v.OutputLocation != nil {
+			cb(ctx, "Query results written to %s", aws.ToString(v.OutputLocation))
+		}
+
+		statistics, err := findQueryRuntimeStatisticsByID(ctx, conn, queryExecutionID)
+		if err != nil {
+			// Runtime statistics are not available for all statement types, e.g. DDL.
+			tflog.Warn(ctx, "Unable to read Athena query runtime statistics", map[string]any{
+				"query_execution_id": queryExecutionID,
+				"error":              err.Error(),
+			})
+		} else if statistics.Rows != nil && statistics.Rows.OutputRows != nil {
+			cb(ctx, "Query returned %d rows", aws.ToInt64(statistics.Rows.OutputRows))
+		}
+	}
+
+	tflog.Info(ctx, "Athena start query execution action completed successfully", map[string]any{
+		"query_execution_id": queryExecutionID,
+	})
+}
+
+// queryExecutionFailureReason returns the most specific failure detail available for a query execution.
+func queryExecutionFailureReason(execution *awstypes.QueryExecution) string {
+	if execution == nil || execution.Status == nil {
+		return ""
+	}
+
+	if v := execution.Status.AthenaError; v != nil && aws.ToString(v.ErrorMessage) != "" {
+		return aws.ToString(v.ErrorMessage)
+	}
+
+	return aws.ToString(execution.Status.StateChangeReason)
+}
+
+func findQueryExecutionByID(ctx context.Context, conn *athena.Client, id string) (*awstypes.QueryExecution, error) {
+	input := athena.GetQueryExecutionInput{
+		QueryExecutionId: aws.String(id),
+	}
+
+	output, err := conn.GetQueryExecution(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.QueryExecution == nil || output.QueryExecution.Status == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.QueryExecution, nil
+}
+
+func findQueryRuntimeStatisticsByID(ctx context.Context, conn *athena.Client, id string) (*awstypes.QueryRuntimeStatistics, error) {
+	input := athena.GetQueryRuntimeStatisticsInput{
+		QueryExecutionId: aws.String(id),
+	}
+
+	output, err := conn.GetQueryRuntimeStatistics(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.QueryRuntimeStatistics == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.QueryRuntimeStatistics, nil
+}
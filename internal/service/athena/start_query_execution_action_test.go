@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package athena_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	"github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAthenaStartQueryExecutionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckWorkGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartQueryExecutionActionConfig_basic(rName, "SELECT 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueryExecutionState(ctx, t, "aws_athena_workgroup.test", types.QueryExecutionStateSucceeded),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaStartQueryExecutionAction_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckWorkGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartQueryExecutionActionConfig_basic(rName, "SELECT * FROM tf_acc_test_does_not_exist"),
+				ExpectError: regexache.MustCompile(`(?s)Query Execution Failed.*tf_acc_test_does_not_exist`),
+			},
+		},
+	})
+}
+
+func testAccCheckQueryExecutionState(ctx context.Context, t *testing.T, n string, state types.QueryExecutionState) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).AthenaClient(ctx)
+
+		input := athena.ListQueryExecutionsInput{
+			WorkGroup: aws.String(rs.Primary.ID),
+		}
+		output, err := conn.ListQueryExecutions(ctx, &input)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range output.QueryExecutionIds {
+			input := athena.GetQueryExecutionInput{
+				QueryExecutionId: aws.String(id),
+			}
+			output, err := conn.GetQueryExecution(ctx, &input)
+			if err != nil {
+				return err
+			}
+
+			if output.QueryExecution.Status.State == state {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Athena WorkGroup %s has no query execution in state %s", rs.Primary.ID, state)
+	}
+}
+
+func testAccStartQueryExecutionActionConfig_basic(rName, query string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_athena_workgroup" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+action "aws_athena_start_query_execution" "test" {
+  config {
+    query_string      = %[2]q
+    work_group        = aws_athena_workgroup.test.name
+    output_location   = "s3://${aws_s3_bucket.test.bucket}/results/"
+    report_statistics = true
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_athena_start_query_execution.test]
+    }
+  }
+}
+`, rName, query)
+}
@@ -48,6 +48,7 @@ func TestAccAutoScalingLifecycleHook_Identity_basic(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:     knownvalue.Null(),
 						names.AttrAccountID:      tfknownvalue.AccountID(),
 						names.AttrRegion:         knownvalue.StringExact(acctest.Region()),
 						"autoscaling_group_name": knownvalue.NotNull(),
@@ -136,6 +137,7 @@ func TestAccAutoScalingLifecycleHook_Identity_regionOverride(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:     knownvalue.Null(),
 						names.AttrAccountID:      tfknownvalue.AccountID(),
 						names.AttrRegion:         knownvalue.StringExact(acctest.AlternateRegion()),
 						"autoscaling_group_name": knownvalue.NotNull(),
@@ -248,6 +250,7 @@ func TestAccAutoScalingLifecycleHook_Identity_ExistingResource_basic(t *testing.
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:     knownvalue.Null(),
 						names.AttrAccountID:      tfknownvalue.AccountID(),
 						names.AttrRegion:         knownvalue.StringExact(acctest.Region()),
 						"autoscaling_group_name": knownvalue.NotNull(),
@@ -54,6 +54,7 @@ func TestAccAutoScalingSchedule_Identity_basic(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:     knownvalue.Null(),
 						names.AttrAccountID:      tfknownvalue.AccountID(),
 						names.AttrRegion:         knownvalue.StringExact(acctest.Region()),
 						"autoscaling_group_name": knownvalue.NotNull(),
@@ -152,6 +153,7 @@ func TestAccAutoScalingSchedule_Identity_regionOverride(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:     knownvalue.Null(),
 						names.AttrAccountID:      tfknownvalue.AccountID(),
 						names.AttrRegion:         knownvalue.StringExact(acctest.AlternateRegion()),
 						"autoscaling_group_name": knownvalue.NotNull(),
@@ -277,6 +279,7 @@ func TestAccAutoScalingSchedule_Identity_ExistingResource_basic(t *testing.T) {
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:     knownvalue.Null(),
 						names.AttrAccountID:      tfknownvalue.AccountID(),
 						names.AttrRegion:         knownvalue.StringExact(acctest.Region()),
 						"autoscaling_group_name": knownvalue.NotNull(),
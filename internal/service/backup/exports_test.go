@@ -22,10 +22,12 @@ var (
 	FindBackupVaultByName                   = findBackupVaultByName // nosemgrep:ci.backup-in-var-name
 	FindFrameworkByName                     = findFrameworkByName
 	FindGlobalSettings                      = findGlobalSettings
+	FindJobByID                             = findJobByID
 	FindLogicallyAirGappedBackupVaultByName = findLogicallyAirGappedBackupVaultByName // nosemgrep:ci.backup-in-var-name
 	FindPlanByID                            = findPlanByID
 	FindRegionSettings                      = findRegionSettings
 	FindReportPlanByName                    = findReportPlanByName
+	FindRestoreJobByID                      = findRestoreJobByID
 	FindRestoreTestingPlanByName            = findRestoreTestingPlanByName
 	FindRestoreTestingSelectionByTwoPartKey = findRestoreTestingSelectionByTwoPartKey
 	FindSelectionByTwoPartKey               = findSelectionByTwoPartKey
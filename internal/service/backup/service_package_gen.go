@@ -20,6 +20,23 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newStartBackupJobAction,
+			TypeName: "aws_backup_start_backup_job",
+			Name:     "Start Backup Job",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newStartRestoreJobAction,
+			TypeName: "aws_backup_start_restore_job",
+			Name:     "Start Restore Job",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{}
 }
@@ -0,0 +1,235 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_backup_start_backup_job, name="Start Backup Job")
+func newStartBackupJobAction(_ context.Context) (action.ActionWithConfigure, error) { // nosemgrep:ci.backup-in-func-name
+	return &startBackupJobAction{}, nil
+}
+
+var (
+	_ action.Action = (*startBackupJobAction)(nil)
+)
+
+type startBackupJobAction struct {
+	framework.ActionWithModel[startBackupJobActionModel]
+}
+
+type startBackupJobActionModel struct {
+	framework.WithRegionModel
+	BackupVaultName       types.String        `tfsdk:"backup_vault_name"`
+	CompleteWindowMinutes types.Int64         `tfsdk:"complete_window_minutes"`
+	IAMRoleARN            fwtypes.ARN         `tfsdk:"iam_role_arn"`
+	RecoveryPointTags     fwtypes.MapOfString `tfsdk:"recovery_point_tags"`
+	ResourceARN           fwtypes.ARN         `tfsdk:"resource_arn"`
+	StartWindowMinutes    types.Int64         `tfsdk:"start_window_minutes"`
+	Timeout               types.Int64         `tfsdk:"timeout"`
+}
+
+func (a *startBackupJobAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an on-demand AWS Backup job for a resource. This action is synchronous and waits for the recovery point to be created.",
+		Attributes: map[string]schema.Attribute{
+			"backup_vault_name": schema.StringAttribute{
+				Description: "Name of the backup vault in which to store the recovery point",
+				Required:    true,
+			},
+			"complete_window_minutes": schema.Int64Attribute{
+				Description: "Number of minutes after the backup job starts before it must complete or be canceled by AWS Backup",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+			names.AttrIAMRoleARN: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "ARN of the IAM role that AWS Backup uses to create the recovery point",
+				Required:    true,
+			},
+			"recovery_point_tags": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Description: "Tags to assign to the recovery point",
+				Optional:    true,
+			},
+			names.AttrResourceARN: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "ARN of the resource to back up",
+				Required:    true,
+			},
+			"start_window_minutes": schema.Int64Attribute{
+				Description: "Number of minutes after a backup is scheduled before the job is canceled if it doesn't start successfully",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the backup job to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *startBackupJobAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startBackupJobActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().BackupClient(ctx)
+
+	resourceARN := fwflex.StringValueFromFramework(ctx, config.ResourceARN)
+	vaultName := fwflex.StringValueFromFramework(ctx, config.BackupVaultName)
+	timeout := fwactions.TimeoutOr(config.Timeout, 60*time.Minute)
+
+	tflog.Info(ctx, "Starting Backup start backup job action", map[string]any{
+		names.AttrResourceARN: resourceARN,
+		"backup_vault_name":   vaultName,
+		names.AttrTimeout:     timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting backup job for %s in vault %s...", resourceARN, vaultName)
+
+	var input backup.StartBackupJobInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.StartBackupJob(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Backup Job",
+			fmt.Sprintf("Could not start backup job for %s: %s", resourceARN, err),
+		)
+		return
+	}
+
+	jobID := aws.ToString(output.BackupJobId)
+	cb(ctx, "Backup job %s started, waiting for completion...", jobID)
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*backup.DescribeBackupJobOutput], error) {
+		job, ferr := findJobByID(ctx, conn, jobID)
+		if ferr != nil {
+			return actionwait.FetchResult[*backup.DescribeBackupJobOutput]{}, fmt.Errorf("describing backup job: %w", ferr)
+		}
+		return actionwait.FetchResult[*backup.DescribeBackupJobOutput]{Status: actionwait.Status(job.State), Value: job}, nil
+	}, actionwait.Options[*backup.DescribeBackupJobOutput]{
+		Timeout:          timeout,
+		Interval:         actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval: time.Minute,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.BackupJobStateCompleted)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.BackupJobStateAborting),
+			actionwait.Status(awstypes.BackupJobStateCreated),
+			actionwait.Status(awstypes.BackupJobStatePending),
+			actionwait.Status(awstypes.BackupJobStateRunning),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.BackupJobStateAborted),
+			actionwait.Status(awstypes.BackupJobStateExpired),
+			actionwait.Status(awstypes.BackupJobStateFailed),
+			actionwait.Status(awstypes.BackupJobStatePartial),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if job, ok := fr.Value.(*backup.DescribeBackupJobOutput); ok && job.PercentDone != nil {
+				cb(ctx, "Backup job %s currently in state: %s (%s%% done)", jobID, fr.Status, aws.ToString(job.PercentDone))
+			} else {
+				cb(ctx, "Backup job %s currently in state: %s", jobID, fr.Status)
+			}
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Backup Job",
+				fmt.Sprintf("Backup job %s did not complete within %s", jobID, timeout),
+			)
+		} else if errors.As(err, &failureErr) {
+			detail := fmt.Sprintf("Backup job %s completed with status: %s", jobID, failureErr.Status)
+			if v := result.Value; v != nil && aws.ToString(v.StatusMessage) != "" {
+				detail += "\n\n" + aws.ToString(v.StatusMessage)
+			}
+			resp.Diagnostics.AddError("Backup Job Failed", detail)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected Backup Job Status", err.Error())
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Backup Job",
+				fmt.Sprintf("Error while waiting for backup job %s: %s", jobID, err),
+			)
+		}
+		return
+	}
+
+	recoveryPointARN := aws.ToString(result.Value.RecoveryPointArn)
+	cb(ctx, "Backup job %s completed successfully, created recovery point %s", jobID, recoveryPointARN)
+
+	tflog.Info(ctx, "Backup start backup job action completed successfully", map[string]any{
+		"backup_job_id":      jobID,
+		"recovery_point_arn": recoveryPointARN,
+	})
+}
+
+func findJobByID(ctx context.Context, conn *backup.Client, id string) (*backup.DescribeBackupJobOutput, error) {
+	input := backup.DescribeBackupJobInput{
+		BackupJobId: aws.String(id),
+	}
+
+	output, err := conn.DescribeBackupJob(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output, nil
+}
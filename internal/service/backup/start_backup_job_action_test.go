@@ -0,0 +1,198 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backup_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBackupStartBackupJobAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	var recoveryPointARN string
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckVaultDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartBackupJobActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultRecoveryPoint(ctx, t, "aws_backup_vault.test", &recoveryPointARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBackupStartBackupJobAction_invalidRole(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckVaultDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartBackupJobActionConfig_invalidRole(rName),
+				ExpectError: regexache.MustCompile(`Failed to Start Backup Job`),
+			},
+		},
+	})
+}
+
+// testAccCheckVaultRecoveryPoint verifies that the vault contains exactly one recovery point and returns its ARN.
+func testAccCheckVaultRecoveryPoint(ctx context.Context, t *testing.T, n string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).BackupClient(ctx)
+
+		input := backup.ListRecoveryPointsByBackupVaultInput{
+			BackupVaultName: aws.String(rs.Primary.ID),
+		}
+		output, err := conn.ListRecoveryPointsByBackupVault(ctx, &input)
+
+		if err != nil {
+			return err
+		}
+
+		if n := len(output.RecoveryPoints); n != 1 {
+			return fmt.Errorf("Backup Vault %s has %d recovery points, expected 1", rs.Primary.ID, n)
+		}
+
+		*v = aws.ToString(output.RecoveryPoints[0].RecoveryPointArn)
+
+		return nil
+	}
+}
+
+func testAccStartBackupJobActionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "backup.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "backup" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSBackupServiceRolePolicyForBackup"
+}
+
+resource "aws_iam_role_policy_attachment" "restore" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSBackupServiceRolePolicyForRestores"
+}
+
+resource "aws_backup_vault" "test" {
+  name = %[1]q
+
+  force_destroy = true
+}
+
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+}
+`, rName)
+}
+
+func testAccStartBackupJobActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStartBackupJobActionConfig_base(rName), `
+action "aws_backup_start_backup_job" "test" {
+  config {
+    backup_vault_name = aws_backup_vault.test.name
+    iam_role_arn      = aws_iam_role.test.arn
+    resource_arn      = aws_dynamodb_table.test.arn
+
+    recovery_point_tags = {
+      Name = aws_dynamodb_table.test.name
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_dynamodb_table.test.arn
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_backup_start_backup_job.test]
+    }
+  }
+
+  depends_on = [
+    aws_iam_role_policy_attachment.backup,
+    aws_iam_role_policy_attachment.restore,
+  ]
+}
+`)
+}
+
+func testAccStartBackupJobActionConfig_invalidRole(rName string) string {
+	return acctest.ConfigCompose(testAccStartBackupJobActionConfig_base(rName), `
+data "aws_caller_identity" "current" {}
+
+action "aws_backup_start_backup_job" "test" {
+  config {
+    backup_vault_name = aws_backup_vault.test.name
+    iam_role_arn      = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/tf-acc-test-does-not-exist"
+    resource_arn      = aws_dynamodb_table.test.arn
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_dynamodb_table.test.arn
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_backup_start_backup_job.test]
+    }
+  }
+}
+`)
+}
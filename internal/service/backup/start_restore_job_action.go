@@ -0,0 +1,276 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_backup_start_restore_job, name="Start Restore Job")
+func newStartRestoreJobAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startRestoreJobAction{}, nil
+}
+
+var (
+	_ action.Action = (*startRestoreJobAction)(nil)
+)
+
+type startRestoreJobAction struct {
+	framework.ActionWithModel[startRestoreJobActionModel]
+}
+
+type startRestoreJobActionModel struct {
+	framework.WithRegionModel
+	BackupVaultName                  types.String        `tfsdk:"backup_vault_name"`
+	CopySourceTagsToRestoredResource types.Bool          `tfsdk:"copy_source_tags_to_restored_resource"`
+	IAMRoleARN                       fwtypes.ARN         `tfsdk:"iam_role_arn"`
+	Metadata                         fwtypes.MapOfString `tfsdk:"metadata"`
+	RecoveryPointARN                 fwtypes.ARN         `tfsdk:"recovery_point_arn"`
+	ResourceType                     types.String        `tfsdk:"resource_type"`
+	Timeout                          types.Int64         `tfsdk:"timeout"`
+}
+
+func (a *startRestoreJobAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restores an AWS Backup recovery point. This action is synchronous and waits for the restored resource to be created.",
+		Attributes: map[string]schema.Attribute{
+			"backup_vault_name": schema.StringAttribute{
+				Description: "Name of the backup vault that contains the recovery point",
+				Required:    true,
+			},
+			"copy_source_tags_to_restored_resource": schema.BoolAttribute{
+				Description: "Whether to copy the tags of the backed-up resource to the restored resource (DynamoDB only)",
+				Optional:    true,
+			},
+			names.AttrIAMRoleARN: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "ARN of the IAM role that AWS Backup uses to create the restored resource",
+				Optional:    true,
+			},
+			"metadata": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Description: "Resource-specific restore metadata. Values override the metadata captured when the recovery point was created",
+				Optional:    true,
+			},
+			"recovery_point_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "ARN of the recovery point to restore",
+				Required:    true,
+			},
+			names.AttrResourceType: schema.StringAttribute{
+				Description: "Type of resource to restore, e.g. DynamoDB or EBS",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the restore job to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *startRestoreJobAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startRestoreJobActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().BackupClient(ctx)
+
+	recoveryPointARN := fwflex.StringValueFromFramework(ctx, config.RecoveryPointARN)
+	vaultName := fwflex.StringValueFromFramework(ctx, config.BackupVaultName)
+	timeout := fwactions.TimeoutOr(config.Timeout, 60*time.Minute)
+
+	tflog.Info(ctx, "Starting Backup start restore job action", map[string]any{
+		"recovery_point_arn": recoveryPointARN,
+		"backup_vault_name":  vaultName,
+		names.AttrTimeout:    timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting restore job for recovery point %s...", recoveryPointARN)
+
+	// StartRestoreJob requires the full set of restore metadata, so start from the
+	// metadata captured with the recovery point and apply the configured overrides.
+	metadata, err := findRecoveryPointRestoreMetadataByTwoPartKey(ctx, conn, vaultName, recoveryPointARN)
+	if retry.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Recovery Point Not Found",
+			fmt.Sprintf("Recovery point %s was not found in backup vault %s", recoveryPointARN, vaultName),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Get Restore Metadata",
+			fmt.Sprintf("Could not get restore metadata for recovery point %s: %s", recoveryPointARN, err),
+		)
+		return
+	}
+	maps.Copy(metadata, fwflex.ExpandFrameworkStringValueMap(ctx, config.Metadata))
+
+	input := backup.StartRestoreJobInput{
+		CopySourceTagsToRestoredResource: fwflex.BoolValueFromFramework(ctx, config.CopySourceTagsToRestoredResource),
+		IamRoleArn:                       fwflex.StringFromFramework(ctx, config.IAMRoleARN),
+		Metadata:                         metadata,
+		RecoveryPointArn:                 aws.String(recoveryPointARN),
+		ResourceType:                     fwflex.StringFromFramework(ctx, config.ResourceType),
+	}
+
+	output, err := conn.StartRestoreJob(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Restore Job",
+			fmt.Sprintf("Could not start restore job for recovery point %s: %s", recoveryPointARN, err),
+		)
+		return
+	}
+
+	jobID := aws.ToString(output.RestoreJobId)
+	cb(ctx, "Restore job %s started, waiting for completion...", jobID)
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*backup.DescribeRestoreJobOutput], error) {
+		job, ferr := findRestoreJobByID(ctx, conn, jobID)
+		if ferr != nil {
+			return actionwait.FetchResult[*backup.DescribeRestoreJobOutput]{}, fmt.Errorf("describing restore job: %w", ferr)
+		}
+		return actionwait.FetchResult[*backup.DescribeRestoreJobOutput]{Status: actionwait.Status(job.Status), Value: job}, nil
+	}, actionwait.Options[*backup.DescribeRestoreJobOutput]{
+		Timeout:          timeout,
+		Interval:         actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval: time.Minute,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.RestoreJobStatusCompleted)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.RestoreJobStatusPending),
+			actionwait.Status(awstypes.RestoreJobStatusRunning),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.RestoreJobStatusAborted),
+			actionwait.Status(awstypes.RestoreJobStatusFailed),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if job, ok := fr.Value.(*backup.DescribeRestoreJobOutput); ok && job.PercentDone != nil {
+				cb(ctx, "Restore job %s currently in state: %s (%s%% done)", jobID, fr.Status, aws.ToString(job.PercentDone))
+			} else {
+				cb(ctx, "Restore job %s currently in state: %s", jobID, fr.Status)
+			}
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Restore Job",
+				fmt.Sprintf("Restore job %s did not complete within %s", jobID, timeout),
+			)
+		} else if errors.As(err, &failureErr) {
+			detail := fmt.Sprintf("Restore job %s completed with status: %s", jobID, failureErr.Status)
+			if v := result.Value; v != nil && aws.ToString(v.StatusMessage) != "" {
+				detail += "\n\n" + aws.ToString(v.StatusMessage)
+			}
+			resp.Diagnostics.AddError("Restore Job Failed", detail)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected Restore Job Status", err.Error())
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Restore Job",
+				fmt.Sprintf("Error while waiting for restore job %s: %s", jobID, err),
+			)
+		}
+		return
+	}
+
+	createdResourceARN := aws.ToString(result.Value.CreatedResourceArn)
+	cb(ctx, "Restore job %s completed successfully, created resource %s", jobID, createdResourceARN)
+
+	tflog.Info(ctx, "Backup start restore job action completed successfully", map[string]any{
+		"restore_job_id":       jobID,
+		"created_resource_arn": createdResourceARN,
+	})
+}
+
+func findRecoveryPointRestoreMetadataByTwoPartKey(ctx context.Context, conn *backup.Client, backupVaultName, recoveryPointARN string) (map[string]string, error) {
+	input := backup.GetRecoveryPointRestoreMetadataInput{
+		BackupVaultName:  aws.String(backupVaultName),
+		RecoveryPointArn: aws.String(recoveryPointARN),
+	}
+
+	output, err := conn.GetRecoveryPointRestoreMetadata(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	if output.RestoreMetadata == nil {
+		return map[string]string{}, nil
+	}
+
+	return output.RestoreMetadata, nil
+}
+
+func findRestoreJobByID(ctx context.Context, conn *backup.Client, id string) (*backup.DescribeRestoreJobOutput, error) {
+	input := backup.DescribeRestoreJobInput{
+		RestoreJobId: aws.String(id),
+	}
+
+	output, err := conn.DescribeRestoreJob(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output, nil
+}
@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backup_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBackupStartRestoreJobAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	restoredTableName := rName + "-restored"
+	var recoveryPointARN string
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckVaultDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartBackupJobActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultRecoveryPoint(ctx, t, "aws_backup_vault.test", &recoveryPointARN),
+				),
+			},
+			{
+				Config: testAccStartRestoreJobActionConfig_basic(rName, restoredTableName),
+				ConfigVariables: config.Variables{
+					"recovery_point_arn": pointerStringVariable{&recoveryPointARN},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestoredTableExistsAndDelete(ctx, t, restoredTableName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBackupStartRestoreJobAction_recoveryPointNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckVaultDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartRestoreJobActionConfig_basic(rName, rName+"-restored"),
+				ConfigVariables: config.Variables{
+					"recovery_point_arn": config.StringVariable("arn:" + acctest.Partition() + ":backup:" + acctest.Region() + ":123456789012:recovery-point:00000000-0000-0000-0000-000000000000"),
+				},
+				ExpectError: regexache.MustCompile(`Recovery Point Not Found|Failed to Get Restore Metadata`),
+			},
+		},
+	})
+}
+
+// pointerStringVariable is a configuration variable whose value is read when the test step runs,
+// allowing a value captured by an earlier step's checks to be used in a later step's configuration.
+type pointerStringVariable struct {
+	v *string
+}
+
+func (v pointerStringVariable) MarshalJSON() ([]byte, error) {
+	return json.Marshal(aws.ToString(v.v))
+}
+
+// testAccCheckRestoredTableExistsAndDelete verifies that the restored table exists and then deletes it,
+// as it is not managed by Terraform.
+func testAccCheckRestoredTableExistsAndDelete(ctx context.Context, t *testing.T, tableName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).DynamoDBClient(ctx)
+
+		describeInput := dynamodb.DescribeTableInput{
+			TableName: aws.String(tableName),
+		}
+		if _, err := conn.DescribeTable(ctx, &describeInput); err != nil {
+			return fmt.Errorf("reading restored DynamoDB Table (%s): %w", tableName, err)
+		}
+
+		deleteInput := dynamodb.DeleteTableInput{
+			TableName: aws.String(tableName),
+		}
+		if _, err := conn.DeleteTable(ctx, &deleteInput); err != nil {
+			return fmt.Errorf("deleting restored DynamoDB Table (%s): %w", tableName, err)
+		}
+
+		waiter := dynamodb.NewTableNotExistsWaiter(conn)
+		if err := waiter.Wait(ctx, &describeInput, 10*time.Minute); err != nil {
+			return fmt.Errorf("waiting for restored DynamoDB Table (%s) delete: %w", tableName, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccStartRestoreJobActionConfig_basic(rName, restoredTableName string) string {
+	return acctest.ConfigCompose(testAccStartBackupJobActionConfig_base(rName), fmt.Sprintf(`
+variable "recovery_point_arn" {
+  type = string
+}
+
+action "aws_backup_start_restore_job" "test" {
+  config {
+    backup_vault_name  = aws_backup_vault.test.name
+    iam_role_arn       = aws_iam_role.test.arn
+    recovery_point_arn = var.recovery_point_arn
+
+    metadata = {
+      targetTableName = %[1]q
+    }
+  }
+}
+
+resource "terraform_data" "restore" {
+  input = var.recovery_point_arn
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_backup_start_restore_job.test]
+    }
+  }
+
+  depends_on = [
+    aws_iam_role_policy_attachment.backup,
+    aws_iam_role_policy_attachment.restore,
+  ]
+}
+`, restoredTableName))
+}
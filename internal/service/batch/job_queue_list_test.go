@@ -57,13 +57,16 @@ func TestAccBatchJobQueue_List_basic(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_batch_job_queue.test", map[string]knownvalue.Check{
-						names.AttrARN: tfknownvalue.RegionalARNExact("batch", "job-queue/"+rName+"-0"),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrARN:        tfknownvalue.RegionalARNExact("batch", "job-queue/"+rName+"-0"),
 					}),
 					querycheck.ExpectIdentity("aws_batch_job_queue.test", map[string]knownvalue.Check{
-						names.AttrARN: tfknownvalue.RegionalARNExact("batch", "job-queue/"+rName+"-1"),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrARN:        tfknownvalue.RegionalARNExact("batch", "job-queue/"+rName+"-1"),
 					}),
 					querycheck.ExpectIdentity("aws_batch_job_queue.test", map[string]knownvalue.Check{
-						names.AttrARN: tfknownvalue.RegionalARNExact("batch", "job-queue/"+rName+"-2"),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrARN:        tfknownvalue.RegionalARNExact("batch", "job-queue/"+rName+"-2"),
 					}),
 				},
 			},
@@ -112,13 +115,16 @@ func TestAccBatchJobQueue_List_regionOverride(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_batch_job_queue.test", map[string]knownvalue.Check{
-						names.AttrARN: tfknownvalue.RegionalARNAlternateRegionExact("batch", "job-queue/"+rName+"-0"),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrARN:        tfknownvalue.RegionalARNAlternateRegionExact("batch", "job-queue/"+rName+"-0"),
 					}),
 					querycheck.ExpectIdentity("aws_batch_job_queue.test", map[string]knownvalue.Check{
-						names.AttrARN: tfknownvalue.RegionalARNAlternateRegionExact("batch", "job-queue/"+rName+"-1"),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrARN:        tfknownvalue.RegionalARNAlternateRegionExact("batch", "job-queue/"+rName+"-1"),
 					}),
 					querycheck.ExpectIdentity("aws_batch_job_queue.test", map[string]knownvalue.Check{
-						names.AttrARN: tfknownvalue.RegionalARNAlternateRegionExact("batch", "job-queue/"+rName+"-2"),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrARN:        tfknownvalue.RegionalARNAlternateRegionExact("batch", "job-queue/"+rName+"-2"),
 					}),
 				},
 			},
@@ -64,7 +64,8 @@ func testAccBedrockCustomModel_Identity_basic(t *testing.T) {
 					statecheck.CompareValuePairs(resourceName, tfjsonpath.New(names.AttrID), resourceName, tfjsonpath.New("job_arn"), compare.ValuesSame()),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"job_arn": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"job_arn":            knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("job_arn")),
 				},
@@ -154,7 +155,8 @@ func testAccBedrockCustomModel_Identity_regionOverride(t *testing.T) {
 					statecheck.CompareValuePairs(resourceName, tfjsonpath.New(names.AttrID), resourceName, tfjsonpath.New("job_arn"), compare.ValuesSame()),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"job_arn": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"job_arn":            knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("job_arn")),
 				},
@@ -331,7 +333,8 @@ func testAccBedrockCustomModel_Identity_ExistingResource_basic(t *testing.T) {
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"job_arn": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"job_arn":            knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("job_arn")),
 				},
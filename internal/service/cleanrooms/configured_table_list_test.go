@@ -62,14 +62,16 @@ func TestAccCleanRoomsConfiguredTable_List_basic(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_cleanrooms_configured_table.test", map[string]knownvalue.Check{
-						names.AttrID:        knownvalue.NotNull(),
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrID:         knownvalue.NotNull(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
 					}),
 					querycheck.ExpectIdentity("aws_cleanrooms_configured_table.test", map[string]knownvalue.Check{
-						names.AttrID:        knownvalue.NotNull(),
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrID:         knownvalue.NotNull(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
 					}),
 				},
 			},
@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// detectStackDriftPollInterval defines polling cadence for the detect stack drift action.
+	detectStackDriftPollInterval = 5 * time.Second
+)
+
+// @Action(aws_cloudformation_detect_stack_drift, name="Detect Stack Drift")
+func newDetectStackDriftAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &detectStackDriftAction{}, nil
+}
+
+var (
+	_ action.Action = (*detectStackDriftAction)(nil)
+)
+
+type detectStackDriftAction struct {
+	framework.ActionWithModel[detectStackDriftActionModel]
+}
+
+type detectStackDriftActionModel struct {
+	framework.WithRegionModel
+	FailOnDrift types.Bool   `tfsdk:"fail_on_drift"`
+	StackName   types.String `tfsdk:"stack_name"`
+	Timeout     types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *detectStackDriftAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Detects drift on a CloudFormation stack and reports each drifted resource along with its property differences.",
+		Attributes: map[string]schema.Attribute{
+			"fail_on_drift": schema.BoolAttribute{
+				Description: "Whether the action should return an error if the stack has drifted (default: false)",
+				Optional:    true,
+			},
+			"stack_name": schema.StringAttribute{
+				Description: "The name or unique ID of the stack to detect drift on",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for drift detection to complete (default: 900)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(3600),
+				},
+			},
+		},
+	}
+}
+
+func (a *detectStackDriftAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config detectStackDriftActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().CloudFormationClient(ctx)
+
+	stackName := fwflex.StringValueFromFramework(ctx, config.StackName)
+	timeout := fwactions.TimeoutOr(config.Timeout, 900*time.Second)
+
+	tflog.Info(ctx, "Starting CloudFormation detect stack drift action", map[string]any{
+		"stack_name":      stackName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting drift detection for CloudFormation stack %s...", stackName)
+
+	detectionID, err := detectStackDrift(ctx, conn, stackName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Detect Stack Drift",
+			fmt.Sprintf("Could not start drift detection for CloudFormation stack %s: %s", stackName, err),
+		)
+		return
+	}
+
+	cb(ctx, "Drift detection %s started, waiting for completion...", detectionID)
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*cloudformation.DescribeStackDriftDetectionStatusOutput], error) {
+		output, ferr := findStackDriftDetectionStatusByID(ctx, conn, detectionID)
+		if ferr != nil {
+			return actionwait.FetchResult[*cloudformation.DescribeStackDriftDetectionStatusOutput]{}, fmt.Errorf("describing stack drift detection status: %w", ferr)
+		}
+		return actionwait.FetchResult[*cloudformation.DescribeStackDriftDetectionStatusOutput]{Status: actionwait.Status(output.DetectionStatus), Value: output}, nil
+	}, actionwait.Options[*cloudformation.DescribeStackDriftDetectionStatusOutput]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(detectStackDriftPollInterval),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{actionwait.Status(awstypes.StackDriftDetectionStatusDetectionComplete)},
+		TransitionalStates: []actionwait.Status{actionwait.Status(awstypes.StackDriftDetectionStatusDetectionInProgress)},
+		FailureStates:      []actionwait.Status{actionwait.Status(awstypes.StackDriftDetectionStatusDetectionFailed)},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Drift detection %s currently in state: %s", detectionID, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Stack Drift Detection",
+				fmt.Sprintf("Drift detection %s for CloudFormation stack %s did not complete within %s", detectionID, stackName, timeout),
+			)
+		} else if errors.As(err, &failureErr) {
+			detail := fmt.Sprintf("Drift detection %s for CloudFormation stack %s completed with status: %s", detectionID, stackName, failureErr.Status)
+			if v := result.Value; v != nil && aws.ToString(v.DetectionStatusReason) != "" {
+				detail += "\n\n" + aws.ToString(v.DetectionStatusReason)
+			}
+			resp.Diagnostics.AddError("Stack Drift Detection Failed", detail)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected Stack Drift Detection Status", err.Error())
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Stack Drift Detection",
+				fmt.Sprintf("Error while waiting for drift detection %s for CloudFormation stack %s: %s", detectionID, stackName, err),
+			)
+		}
+		return
+	}
+
+	driftStatus := result.Value.StackDriftStatus
+	if driftStatus != awstypes.StackDriftStatusDrifted {
+		cb(ctx, "CloudFormation stack %s drift status: %s", stackName, driftStatus)
+
+		tflog.Info(ctx, "CloudFormation detect stack drift action completed successfully", map[string]any{
+			"stack_name":         stackName,
+			"stack_drift_status": driftStatus,
+		})
+		return
+	}
+
+	drifts, err := findDriftedStackResourcesByName(ctx, conn, stackName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe Stack Resource Drifts",
+			fmt.Sprintf("Could not describe resource drifts for CloudFormation stack %s: %s", stackName, err),
+		)
+		return
+	}
+
+	cb(ctx, "CloudFormation stack %s has drifted: %d resource(s) differ from the template", stackName, len(drifts))
+	for _, drift := range drifts {
+		cb(ctx, "%s (%s, %s) is %s", aws.ToString(drift.LogicalResourceId), aws.ToString(drift.ResourceType), aws.ToString(drift.PhysicalResourceId), drift.StackResourceDriftStatus)
+		for _, v := range drift.PropertyDifferences {
+			cb(ctx, "  %s %s: expected %s, actual %s", v.DifferenceType, aws.ToString(v.PropertyPath), aws.ToString(v.ExpectedValue), aws.ToString(v.ActualValue))
+		}
+	}
+
+	if fwflex.BoolValueFromFramework(ctx, config.FailOnDrift) {
+		resp.Diagnostics.AddError(
+			"Stack Has Drifted",
+			fmt.Sprintf("CloudFormation stack %s has %d drifted resource(s)", stackName, len(drifts)),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "CloudFormation detect stack drift action completed successfully", map[string]any{
+		"stack_name":         stackName,
+		"stack_drift_status": driftStatus,
+	})
+}
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFormationDetectStackDriftAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckStackDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectStackDriftActionConfig_basic(rName, true),
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationDetectStackDriftAction_failOnDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckStackDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackDriftConfig_base(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackQueueDrifted(ctx, t, "aws_cloudformation_stack.test"),
+				),
+			},
+			{
+				Config:      testAccDetectStackDriftActionConfig_basic(rName, true),
+				ExpectError: regexache.MustCompile(`Stack Has Drifted`),
+			},
+		},
+	})
+}
+
+// testAccCheckStackQueueDrifted modifies the stack's queue outside of CloudFormation so that drift detection reports it.
+func testAccCheckStackQueueDrifted(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).SQSClient(ctx)
+
+		input := sqs.SetQueueAttributesInput{
+			Attributes: map[string]string{
+				string(sqstypes.QueueAttributeNameVisibilityTimeout): "120",
+			},
+			QueueUrl: aws.String(rs.Primary.Attributes["outputs.QueueURL"]),
+		}
+		_, err := conn.SetQueueAttributes(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccStackDriftConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+
+  template_body = jsonencode({
+    Resources = {
+      Queue = {
+        Type = "AWS::SQS::Queue"
+        Properties = {
+          QueueName         = %[1]q
+          VisibilityTimeout = 30
+        }
+      }
+    }
+    Outputs = {
+      QueueURL = {
+        Value = { Ref = "Queue" }
+      }
+    }
+  })
+}
+`, rName)
+}
+
+func testAccDetectStackDriftActionConfig_basic(rName string, failOnDrift bool) string {
+	return acctest.ConfigCompose(testAccStackDriftConfig_base(rName), fmt.Sprintf(`
+action "aws_cloudformation_detect_stack_drift" "test" {
+  config {
+    stack_name    = aws_cloudformation_stack.test.name
+    fail_on_drift = %[1]t
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_cloudformation_stack.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_cloudformation_detect_stack_drift.test]
+    }
+  }
+}
+`, failOnDrift))
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func detectStackDrift(ctx context.Context, conn *cloudformation.Client, stackName string) (string, error) {
+	input := cloudformation.DetectStackDriftInput{
+		StackName: aws.String(stackName),
+	}
+
+	output, err := conn.DetectStackDrift(ctx, &input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.StackDriftDetectionId), nil
+}
+
+func findStackDriftDetectionStatusByID(ctx context.Context, conn *cloudformation.Client, id string) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	input := cloudformation.DescribeStackDriftDetectionStatusInput{
+		StackDriftDetectionId: aws.String(id),
+	}
+
+	output, err := conn.DescribeStackDriftDetectionStatus(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output, nil
+}
+
+// findDriftedStackResourcesByName returns the stack's resources whose most recent drift status is MODIFIED or DELETED.
+func findDriftedStackResourcesByName(ctx context.Context, conn *cloudformation.Client, stackName string) ([]awstypes.StackResourceDrift, error) {
+	input := cloudformation.DescribeStackResourceDriftsInput{
+		StackName: aws.String(stackName),
+		StackResourceDriftStatusFilters: []awstypes.StackResourceDriftStatus{
+			awstypes.StackResourceDriftStatusDeleted,
+			awstypes.StackResourceDriftStatusModified,
+		},
+	}
+	var output []awstypes.StackResourceDrift
+
+	pages := cloudformation.NewDescribeStackResourceDriftsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.StackResourceDrifts...)
+	}
+
+	return output, nil
+}
+
+func statusStackDriftDetection(conn *cloudformation.Client, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findStackDriftDetectionStatusByID(ctx, conn, id)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DetectionStatus), nil
+	}
+}
+
+func waitStackDriftDetectionComplete(ctx context.Context, conn *cloudformation.Client, id string, timeout time.Duration) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	stateConf := retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StackDriftDetectionStatusDetectionInProgress),
+		Target:  enum.Slice(awstypes.StackDriftDetectionStatusDetectionComplete),
+		Timeout: timeout,
+		Refresh: statusStackDriftDetection(conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudformation.DescribeStackDriftDetectionStatusOutput); ok {
+		if output.DetectionStatus == awstypes.StackDriftDetectionStatusDetectionFailed {
+			retry.SetLastError(err, errors.New(aws.ToString(output.DetectionStatusReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
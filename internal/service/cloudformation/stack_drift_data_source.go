@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	stackDriftDetectionTimeout = 10 * time.Minute
+)
+
+// @FrameworkDataSource("aws_cloudformation_stack_drift", name="Stack Drift")
+func newStackDriftDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &stackDriftDataSource{}, nil
+}
+
+type stackDriftDataSource struct {
+	framework.DataSourceWithModel[stackDriftDataSourceModel]
+}
+
+func (d *stackDriftDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"drifted_resources": framework.DataSourceComputedListOfObjectAttribute[stackResourceDriftModel](ctx),
+			"drifted_stack_resource_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"stack_drift_detection_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"stack_drift_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.StackDriftStatus](),
+				Computed:   true,
+			},
+			"stack_name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *stackDriftDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data stackDriftDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().CloudFormationClient(ctx)
+
+	stackName := fwflex.StringValueFromFramework(ctx, data.StackName)
+	detectionID, err := detectStackDrift(ctx, conn, stackName)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("detecting CloudFormation Stack (%s) drift", stackName), err.Error())
+
+		return
+	}
+
+	output, err := waitStackDriftDetectionComplete(ctx, conn, detectionID, stackDriftDetectionTimeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFormation Stack (%s) drift detection (%s)", stackName, detectionID), err.Error())
+
+		return
+	}
+
+	var drifts []awstypes.StackResourceDrift
+	if output.StackDriftStatus == awstypes.StackDriftStatusDrifted {
+		drifts, err = findDriftedStackResourcesByName(ctx, conn, stackName)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading CloudFormation Stack (%s) resource drifts", stackName), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, drifts, &data.DriftedResources)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.StackId)
+	data.StackName = types.StringValue(stackName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type stackDriftDataSourceModel struct {
+	framework.WithRegionModel
+	DriftedResources          fwtypes.ListNestedObjectValueOf[stackResourceDriftModel] `tfsdk:"drifted_resources"`
+	DriftedStackResourceCount types.Int64                                              `tfsdk:"drifted_stack_resource_count"`
+	ID                        types.String                                             `tfsdk:"id"`
+	StackDriftDetectionID     types.String                                             `tfsdk:"stack_drift_detection_id"`
+	StackDriftStatus          fwtypes.StringEnum[awstypes.StackDriftStatus]            `tfsdk:"stack_drift_status"`
+	StackName                 types.String                                             `tfsdk:"stack_name"`
+}
+
+type stackResourceDriftModel struct {
+	LogicalResourceID        types.String                                             `tfsdk:"logical_resource_id"`
+	PhysicalResourceID       types.String                                             `tfsdk:"physical_resource_id"`
+	PropertyDifferences      fwtypes.ListNestedObjectValueOf[propertyDifferenceModel] `tfsdk:"property_differences"`
+	ResourceType             types.String                                             `tfsdk:"resource_type"`
+	StackResourceDriftStatus fwtypes.StringEnum[awstypes.StackResourceDriftStatus]    `tfsdk:"stack_resource_drift_status"`
+}
+
+type propertyDifferenceModel struct {
+	ActualValue    types.String                                `tfsdk:"actual_value"`
+	DifferenceType fwtypes.StringEnum[awstypes.DifferenceType] `tfsdk:"difference_type"`
+	ExpectedValue  types.String                                `tfsdk:"expected_value"`
+	PropertyPath   types.String                                `tfsdk:"property_path"`
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFormationStackDriftDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudformation_stack_drift.test"
+	resourceName := "aws_cloudformation_stack.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackDriftDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "drifted_resources.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "drifted_stack_resource_count", "0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "stack_drift_detection_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "stack_drift_status", "IN_SYNC"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationStackDriftDataSource_drifted(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudformation_stack_drift.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackDriftConfig_base(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackQueueDrifted(ctx, t, "aws_cloudformation_stack.test"),
+				),
+			},
+			{
+				Config: testAccStackDriftDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "drifted_resources.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "drifted_resources.0.logical_resource_id", "Queue"),
+					resource.TestCheckResourceAttr(dataSourceName, "drifted_resources.0.resource_type", "AWS::SQS::Queue"),
+					resource.TestCheckResourceAttr(dataSourceName, "drifted_resources.0.stack_resource_drift_status", "MODIFIED"),
+					resource.TestCheckResourceAttr(dataSourceName, "drifted_resources.0.property_differences.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "drifted_resources.0.property_differences.0.property_path", "/VisibilityTimeout"),
+					resource.TestCheckResourceAttr(dataSourceName, "drifted_resources.0.property_differences.0.expected_value", "30"),
+					resource.TestCheckResourceAttr(dataSourceName, "drifted_resources.0.property_differences.0.actual_value", "120"),
+					resource.TestCheckResourceAttr(dataSourceName, "drifted_resources.0.property_differences.0.difference_type", "NOT_EQUAL"),
+					resource.TestCheckResourceAttr(dataSourceName, "drifted_stack_resource_count", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "stack_drift_status", "DRIFTED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStackDriftDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStackDriftConfig_base(rName), `
+data "aws_cloudformation_stack_drift" "test" {
+  stack_name = aws_cloudformation_stack.test.name
+}
+`)
+}
@@ -62,14 +62,16 @@ func TestAccCloudWatchMetricAlarm_List_basic(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_cloudwatch_metric_alarm.test", map[string]knownvalue.Check{
-						"alarm_name":        knownvalue.StringExact(rName + "-0"),
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"alarm_name":         knownvalue.StringExact(rName + "-0"),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
 					}),
 					querycheck.ExpectIdentity("aws_cloudwatch_metric_alarm.test", map[string]knownvalue.Check{
-						"alarm_name":        knownvalue.StringExact(rName + "-1"),
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"alarm_name":         knownvalue.StringExact(rName + "-1"),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
 					}),
 				},
 			},
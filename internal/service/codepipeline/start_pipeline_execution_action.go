@@ -0,0 +1,420 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package codepipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_codepipeline_start_pipeline_execution, name="Start Pipeline Execution")
+func newStartPipelineExecutionAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startPipelineExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startPipelineExecutionAction)(nil)
+)
+
+type startPipelineExecutionAction struct {
+	framework.ActionWithModel[startPipelineExecutionActionModel]
+}
+
+type startPipelineExecutionActionModel struct {
+	framework.WithRegionModel
+	PipelineName    types.String                                                 `tfsdk:"pipeline_name"`
+	SourceRevisions fwtypes.ListNestedObjectValueOf[sourceRevisionOverrideModel] `tfsdk:"source_revision"`
+	Timeout         types.Int64                                                  `tfsdk:"timeout"`
+	Variables       fwtypes.ListNestedObjectValueOf[pipelineVariableModel]       `tfsdk:"variable"`
+}
+
+type sourceRevisionOverrideModel struct {
+	ActionName    types.String                                    `tfsdk:"action_name"`
+	RevisionType  fwtypes.StringEnum[awstypes.SourceRevisionType] `tfsdk:"revision_type"`
+	RevisionValue types.String                                    `tfsdk:"revision_value"`
+}
+
+type pipelineVariableModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (a *startPipelineExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a CodePipeline pipeline execution. This action is synchronous and waits for the execution to complete, reporting stage and action status changes as progress.",
+		Attributes: map[string]schema.Attribute{
+			"pipeline_name": schema.StringAttribute{
+				Description: "Name of the pipeline to start",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the pipeline execution to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(43200),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"source_revision": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[sourceRevisionOverrideModel](ctx),
+				Description: "Source revisions to use for this execution instead of the latest revision",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(50),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"action_name": schema.StringAttribute{
+							Description: "Name of the source action to override",
+							Required:    true,
+						},
+						"revision_type": schema.StringAttribute{
+							CustomType:  fwtypes.StringEnumType[awstypes.SourceRevisionType](),
+							Description: "Type of the source revision",
+							Required:    true,
+						},
+						"revision_value": schema.StringAttribute{
+							Description: "Source revision, such as a commit ID or S3 object version ID",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"variable": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[pipelineVariableModel](ctx),
+				Description: "Pipeline-level variables to set for this execution",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(50),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Description: "Name of the pipeline variable",
+							Required:    true,
+						},
+						names.AttrValue: schema.StringAttribute{
+							Description: "Value of the pipeline variable",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *startPipelineExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startPipelineExecutionActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().CodePipelineClient(ctx)
+
+	pipelineName := fwflex.StringValueFromFramework(ctx, config.PipelineName)
+	timeout := fwactions.TimeoutOr(config.Timeout, 60*time.Minute)
+
+	tflog.Info(ctx, "Starting CodePipeline pipeline execution action", map[string]any{
+		"pipeline_name":   pipelineName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting execution of CodePipeline pipeline %s...", pipelineName)
+
+	var input codepipeline.StartPipelineExecutionInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Name = aws.String(pipelineName)
+
+	output, err := conn.StartPipelineExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Pipeline Execution",
+			fmt.Sprintf("Could not start execution of CodePipeline pipeline %s: %s", pipelineName, err),
+		)
+		return
+	}
+
+	executionID := aws.ToString(output.PipelineExecutionId)
+	cb(ctx, "Pipeline execution %s started, waiting for completion...", executionID)
+
+	// Stage and action status changes are reported as they are observed,
+	// independently of the throttled periodic progress message.
+	tracker := newPipelineExecutionTracker()
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.PipelineExecution], error) {
+		execution, ferr := findPipelineExecutionByTwoPartKey(ctx, conn, pipelineName, executionID)
+		if ferr != nil {
+			return actionwait.FetchResult[*awstypes.PipelineExecution]{}, fmt.Errorf("getting pipeline execution: %w", ferr)
+		}
+
+		if ferr := tracker.refresh(ctx, conn, pipelineName, executionID); ferr != nil {
+			tflog.Warn(ctx, "Unable to read CodePipeline stage progress", map[string]any{
+				"pipeline_name":         pipelineName,
+				"pipeline_execution_id": executionID,
+				"error":                 ferr.Error(),
+			})
+		}
+		for _, message := range tracker.drain() {
+			cb(ctx, "%s", message)
+		}
+
+		return actionwait.FetchResult[*awstypes.PipelineExecution]{Status: actionwait.Status(execution.Status), Value: execution}, nil
+	}, actionwait.Options[*awstypes.PipelineExecution]{
+		Timeout:          timeout,
+		Interval:         actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.PipelineExecutionStatusSucceeded)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.PipelineExecutionStatusInProgress),
+			actionwait.Status(awstypes.PipelineExecutionStatusStopping),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.PipelineExecutionStatusCancelled),
+			actionwait.Status(awstypes.PipelineExecutionStatusFailed),
+			actionwait.Status(awstypes.PipelineExecutionStatusStopped),
+			actionwait.Status(awstypes.PipelineExecutionStatusSuperseded),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Pipeline execution %s currently in state: %s", executionID, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Pipeline Execution",
+				fmt.Sprintf("CodePipeline pipeline %s execution %s did not complete within %s", pipelineName, executionID, timeout),
+			)
+		} else if errors.As(err, &failureErr) {
+			var summary []string
+			if v := result.Value; v != nil && aws.ToString(v.StatusSummary) != "" {
+				summary = append(summary, aws.ToString(v.StatusSummary))
+			}
+			summary = append(summary, tracker.failures()...)
+			detail := fmt.Sprintf("CodePipeline pipeline %s execution %s completed with status: %s", pipelineName, executionID, failureErr.Status)
+			if len(summary) > 0 {
+				detail += "\n\n" + strings.Join(summary, "\n")
+			}
+			resp.Diagnostics.AddError("Pipeline Execution Failed", detail)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected Pipeline Execution Status", err.Error())
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Pipeline Execution",
+				fmt.Sprintf("Error while waiting for CodePipeline pipeline %s execution %s: %s", pipelineName, executionID, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Pipeline execution %s completed successfully", executionID)
+
+	tflog.Info(ctx, "CodePipeline start pipeline execution action completed successfully", map[string]any{
+		"pipeline_name":         pipelineName,
+		"pipeline_execution_id": executionID,
+	})
+}
+
+// pipelineExecutionTracker records the last observed status of each stage and action
+// in a pipeline execution so that only changes are reported.
+type pipelineExecutionTracker struct {
+	stages   map[string]string
+	actions  map[string]string
+	errors   map[string]string
+	messages []string
+}
+
+func newPipelineExecutionTracker() *pipelineExecutionTracker {
+	return &pipelineExecutionTracker{
+		stages:  make(map[string]string),
+		actions: make(map[string]string),
+		errors:  make(map[string]string),
+	}
+}
+
+func (t *pipelineExecutionTracker) refresh(ctx context.Context, conn *codepipeline.Client, pipelineName, executionID string) error {
+	state, err := findPipelineStateByName(ctx, conn, pipelineName)
+	if err != nil {
+		return err
+	}
+
+	for _, stage := range state.StageStates {
+		if stage.LatestExecution == nil || aws.ToString(stage.LatestExecution.PipelineExecutionId) != executionID {
+			continue
+		}
+		name, status := aws.ToString(stage.StageName), string(stage.LatestExecution.Status)
+		if t.stages[name] != status {
+			t.stages[name] = status
+			t.messages = append(t.messages, fmt.Sprintf("Stage %s: %s", name, status))
+		}
+	}
+
+	actions, err := findActionExecutionsByTwoPartKey(ctx, conn, pipelineName, executionID)
+	if err != nil {
+		return err
+	}
+
+	// ListActionExecutions returns the most recent executions first.
+	for i := len(actions) - 1; i >= 0; i-- {
+		v := actions[i]
+		key := aws.ToString(v.StageName) + "/" + aws.ToString(v.ActionName)
+		status := string(v.Status)
+		if t.actions[key] == status {
+			continue
+		}
+		t.actions[key] = status
+
+		message := fmt.Sprintf("Action %s: %s", key, status)
+		if v.Output != nil && v.Output.ExecutionResult != nil {
+			result := v.Output.ExecutionResult
+			if summary := aws.ToString(result.ExternalExecutionSummary); summary != "" {
+				message += " (" + summary + ")"
+			}
+			if v.Status == awstypes.ActionExecutionStatusFailed && result.ErrorDetails != nil {
+				t.errors[key] = fmt.Sprintf("%s: %s", aws.ToString(result.ErrorDetails.Code), aws.ToString(result.ErrorDetails.Message))
+			}
+		}
+		t.messages = append(t.messages, message)
+	}
+
+	return nil
+}
+
+// drain returns and clears the pending status change messages.
+func (t *pipelineExecutionTracker) drain() []string {
+	messages := t.messages
+	t.messages = nil
+	return messages
+}
+
+// failures returns a description of each failed action.
+func (t *pipelineExecutionTracker) failures() []string {
+	var failures []string
+
+	for _, key := range slices.Sorted(maps.Keys(t.actions)) {
+		if status := t.actions[key]; status != string(awstypes.ActionExecutionStatusFailed) {
+			continue
+		}
+		if v, ok := t.errors[key]; ok {
+			failures = append(failures, fmt.Sprintf("Action %s failed: %s", key, v))
+		} else {
+			failures = append(failures, fmt.Sprintf("Action %s failed", key))
+		}
+	}
+
+	return failures
+}
+
+func findPipelineExecutionByTwoPartKey(ctx context.Context, conn *codepipeline.Client, pipelineName, executionID string) (*awstypes.PipelineExecution, error) {
+	input := codepipeline.GetPipelineExecutionInput{
+		PipelineExecutionId: aws.String(executionID),
+		PipelineName:        aws.String(pipelineName),
+	}
+
+	output, err := conn.GetPipelineExecution(ctx, &input)
+
+	if errs.IsA[*awstypes.PipelineNotFoundException](err) || errs.IsA[*awstypes.PipelineExecutionNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PipelineExecution == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.PipelineExecution, nil
+}
+
+func findPipelineStateByName(ctx context.Context, conn *codepipeline.Client, name string) (*codepipeline.GetPipelineStateOutput, error) {
+	input := codepipeline.GetPipelineStateInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetPipelineState(ctx, &input)
+
+	if errs.IsA[*awstypes.PipelineNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output, nil
+}
+
+func findActionExecutionsByTwoPartKey(ctx context.Context, conn *codepipeline.Client, pipelineName, executionID string) ([]awstypes.ActionExecutionDetail, error) {
+	input := codepipeline.ListActionExecutionsInput{
+		Filter: &awstypes.ActionExecutionFilter{
+			PipelineExecutionId: aws.String(executionID),
+		},
+		PipelineName: aws.String(pipelineName),
+	}
+	var output []awstypes.ActionExecutionDetail
+
+	pages := codepipeline.NewListActionExecutionsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.PipelineNotFoundException](err) || errs.IsA[*awstypes.PipelineExecutionNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ActionExecutionDetails...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,230 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package codepipeline_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCodePipelineStartPipelineExecutionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodePipelineServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckPipelineDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartPipelineExecutionActionConfig_basic(rName, "source.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExecutionStarted(ctx, t, "aws_codepipeline.test", types.PipelineExecutionStatusSucceeded),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodePipelineStartPipelineExecutionAction_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodePipelineServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckPipelineDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartPipelineExecutionActionConfig_basic(rName, "missing.zip"),
+				ExpectError: regexache.MustCompile(`Pipeline Execution Failed`),
+			},
+		},
+	})
+}
+
+func testAccCheckPipelineExecutionStarted(ctx context.Context, t *testing.T, n string, status types.PipelineExecutionStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).CodePipelineClient(ctx)
+
+		input := codepipeline.ListPipelineExecutionsInput{
+			PipelineName: aws.String(rs.Primary.ID),
+		}
+		pages := codepipeline.NewListPipelineExecutionsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				return err
+			}
+
+			for _, v := range page.PipelineExecutionSummaries {
+				if v.Trigger != nil && v.Trigger.TriggerType == types.TriggerTypeStartPipelineExecution && v.Status == status {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("CodePipeline Pipeline %s has no %s execution started by StartPipelineExecution", rs.Primary.ID, status)
+	}
+}
+
+func testAccStartPipelineExecutionActionConfig_basic(rName, objectKey string) string {
+	return acctest.ConfigCompose(
+		testAccS3DefaultBucket(rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket_versioning.test.bucket
+  key     = "source.zip"
+  content = "test"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "codepipeline.amazonaws.com"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "s3:GetBucketVersioning",
+        "s3:GetObject",
+        "s3:GetObjectVersion",
+        "s3:PutObject",
+      ]
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_codepipeline" "test" {
+  name           = %[1]q
+  role_arn       = aws_iam_role.test.arn
+  pipeline_type  = "V2"
+  execution_mode = "QUEUED"
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  variable {
+    name          = "environment"
+    default_value = "test"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "S3"
+      version          = "1"
+      output_artifacts = ["source"]
+
+      configuration = {
+        S3Bucket             = aws_s3_bucket.test.bucket
+        S3ObjectKey          = %[2]q
+        PollForSourceChanges = "false"
+      }
+    }
+  }
+
+  stage {
+    name = "Deploy"
+
+    action {
+      name            = "Deploy"
+      category        = "Deploy"
+      owner           = "AWS"
+      provider        = "S3"
+      version         = "1"
+      input_artifacts = ["source"]
+
+      configuration = {
+        BucketName = aws_s3_bucket.test.bucket
+        Extract    = "false"
+        ObjectKey  = "deployed/#{variables.environment}.zip"
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_object.test]
+}
+
+action "aws_codepipeline_start_pipeline_execution" "test" {
+  config {
+    pipeline_name = aws_codepipeline.test.name
+    timeout       = 1200
+
+    variable {
+      name  = "environment"
+      value = "action"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_codepipeline_start_pipeline_execution.test]
+    }
+  }
+}
+`, rName, objectKey))
+}
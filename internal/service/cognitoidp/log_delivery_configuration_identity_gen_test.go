@@ -49,6 +49,7 @@ func TestAccCognitoIDPLogDeliveryConfiguration_Identity_basic(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole: knownvalue.Null(),
 						names.AttrAccountID:  tfknownvalue.AccountID(),
 						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
 						names.AttrUserPoolID: knownvalue.NotNull(),
@@ -134,6 +135,7 @@ func TestAccCognitoIDPLogDeliveryConfiguration_Identity_regionOverride(t *testin
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole: knownvalue.Null(),
 						names.AttrAccountID:  tfknownvalue.AccountID(),
 						names.AttrRegion:     knownvalue.StringExact(acctest.AlternateRegion()),
 						names.AttrUserPoolID: knownvalue.NotNull(),
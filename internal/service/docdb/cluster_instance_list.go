@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package docdb
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/docdb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/docdb/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_docdb_cluster_instance")
+func newClusterInstanceResourceAsListResource() inttypes.ListResourceForSDK {
+	l := clusterInstanceListResource{}
+	l.SetResourceSchema(resourceClusterInstance())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &clusterInstanceListResource{}
+
+type clusterInstanceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type clusterInstanceListResourceModel struct {
+	framework.WithRegionModel
+	ClusterIdentifier types.String `tfsdk:"cluster_identifier"`
+	Tags              tftags.Map   `tfsdk:"tags"`
+}
+
+func (l *clusterInstanceListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrClusterIdentifier: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Identifier of the DB cluster. Only DB instances belonging to this DB cluster are listed.",
+			},
+			names.AttrTags: framework.ListTagsFilterAttribute(),
+		},
+	}
+}
+
+func (l *clusterInstanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.DocDBClient(ctx)
+
+	var query clusterInstanceListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input docdb.DescribeDBInstancesInput
+	input.Filters = appendListFilter(input.Filters, "db-cluster-id", query.ClusterIdentifier.ValueString())
+
+	tflog.Info(ctx, "Listing DocumentDB Cluster Instances")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for instance, err := range listDBInstances(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if aws.ToString(instance.Engine) != engineDocDB {
+				continue
+			}
+
+			if len(query.Tags.Elements()) > 0 {
+				tags, err := listTags(ctx, conn, aws.ToString(instance.DBInstanceArn))
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("listing tags for DocumentDB Cluster Instance (%s): %w", aws.ToString(instance.DBInstanceArn), err))
+					yield(result)
+					return
+				}
+
+				if !framework.MatchesTagsFilter(ctx, query.Tags, tags) {
+					continue
+				}
+			}
+
+			id := aws.ToString(instance.DBInstanceIdentifier)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading DocumentDB Cluster Instance")
+				if diags := resourceClusterInstanceRead(ctx, rd, awsClient); diags.HasError() {
+					tflog.Error(ctx, "Reading DocumentDB Cluster Instance", map[string]any{
+						"diags": sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				if rd.Id() == "" {
+					continue
+				}
+			}
+
+			result.DisplayName = id
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listDBInstances(ctx context.Context, conn *docdb.Client, input *docdb.DescribeDBInstancesInput) iter.Seq2[awstypes.DBInstance, error] {
+	return func(yield func(awstypes.DBInstance, error) bool) {
+		pages := docdb.NewDescribeDBInstancesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.DBInstance{}, fmt.Errorf("listing DocumentDB Cluster Instances: %w", err))
+				return
+			}
+
+			for _, v := range page.DBInstances {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package docdb
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/docdb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/docdb/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_docdb_cluster")
+func newClusterResourceAsListResource() inttypes.ListResourceForSDK {
+	l := clusterListResource{}
+	l.SetResourceSchema(resourceCluster())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &clusterListResource{}
+
+type clusterListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type clusterListResourceModel struct {
+	framework.WithRegionModel
+	Tags tftags.Map `tfsdk:"tags"`
+}
+
+func (l *clusterListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrTags: framework.ListTagsFilterAttribute(),
+		},
+	}
+}
+
+func (l *clusterListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.DocDBClient(ctx)
+
+	var query clusterListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input docdb.DescribeDBClustersInput
+
+	tflog.Info(ctx, "Listing DocumentDB Clusters")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for cluster, err := range listDBClusters(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if aws.ToString(cluster.Engine) != engineDocDB {
+				continue
+			}
+
+			if len(query.Tags.Elements()) > 0 {
+				tags, err := listTags(ctx, conn, aws.ToString(cluster.DBClusterArn))
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("listing tags for DocumentDB Cluster (%s): %w", aws.ToString(cluster.DBClusterArn), err))
+					yield(result)
+					return
+				}
+
+				if !framework.MatchesTagsFilter(ctx, query.Tags, tags) {
+					continue
+				}
+			}
+
+			id := aws.ToString(cluster.DBClusterIdentifier)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading DocumentDB Cluster")
+				if diags := resourceClusterRead(ctx, rd, awsClient); diags.HasError() {
+					tflog.Error(ctx, "Reading DocumentDB Cluster", map[string]any{
+						"diags": sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				if rd.Id() == "" {
+					continue
+				}
+			}
+
+			result.DisplayName = id
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listDBClusters(ctx context.Context, conn *docdb.Client, input *docdb.DescribeDBClustersInput) iter.Seq2[awstypes.DBCluster, error] {
+	return func(yield func(awstypes.DBCluster, error) bool) {
+		pages := docdb.NewDescribeDBClustersPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.DBCluster{}, fmt.Errorf("listing DocumentDB Clusters: %w", err))
+				return
+			}
+
+			for _, v := range page.DBClusters {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -31,6 +31,9 @@ import (
 
 // @SDKResource("aws_docdb_cluster_parameter_group", name="Cluster Parameter Group")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/docdb/types;awstypes;awstypes.DBClusterParameterGroup")
+// @Testing(preIdentityVersion="v6.42.0")
 func resourceClusterParameterGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterParameterGroupCreate,
@@ -38,10 +41,6 @@ func resourceClusterParameterGroup() *schema.Resource {
 		UpdateWithoutTimeout: resourceClusterParameterGroupUpdate,
 		DeleteWithoutTimeout: resourceClusterParameterGroupDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
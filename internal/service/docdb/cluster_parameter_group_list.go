@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package docdb
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/docdb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/docdb/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_docdb_cluster_parameter_group")
+func newClusterParameterGroupResourceAsListResource() inttypes.ListResourceForSDK {
+	l := clusterParameterGroupListResource{}
+	l.SetResourceSchema(resourceClusterParameterGroup())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &clusterParameterGroupListResource{}
+
+type clusterParameterGroupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type clusterParameterGroupListResourceModel struct {
+	framework.WithRegionModel
+	Family types.String `tfsdk:"family"`
+	Tags   tftags.Map   `tfsdk:"tags"`
+}
+
+func (l *clusterParameterGroupListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrFamily: listschema.StringAttribute{
+				Optional:    true,
+				Description: "DB cluster parameter group family. Only DB cluster parameter groups in this family are listed.",
+			},
+			names.AttrTags: framework.ListTagsFilterAttribute(),
+		},
+	}
+}
+
+func (l *clusterParameterGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.DocDBClient(ctx)
+
+	var query clusterParameterGroupListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input docdb.DescribeDBClusterParameterGroupsInput
+
+	tflog.Info(ctx, "Listing DocumentDB Cluster Parameter Groups")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for group, err := range listDBClusterParameterGroups(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// Default parameter groups cannot be managed.
+			if strings.HasPrefix(aws.ToString(group.DBClusterParameterGroupName), "default.") {
+				continue
+			}
+
+			if family := aws.ToString(group.DBParameterGroupFamily); !strings.HasPrefix(family, "docdb") || (!query.Family.IsNull() && family != query.Family.ValueString()) {
+				continue
+			}
+
+			if len(query.Tags.Elements()) > 0 {
+				tags, err := listTags(ctx, conn, aws.ToString(group.DBClusterParameterGroupArn))
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("listing tags for DocumentDB Cluster Parameter Group (%s): %w", aws.ToString(group.DBClusterParameterGroupArn), err))
+					yield(result)
+					return
+				}
+
+				if !framework.MatchesTagsFilter(ctx, query.Tags, tags) {
+					continue
+				}
+			}
+
+			id := aws.ToString(group.DBClusterParameterGroupName)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading DocumentDB Cluster Parameter Group")
+				if diags := resourceClusterParameterGroupRead(ctx, rd, awsClient); diags.HasError() {
+					tflog.Error(ctx, "Reading DocumentDB Cluster Parameter Group", map[string]any{
+						"diags": sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				if rd.Id() == "" {
+					continue
+				}
+			}
+
+			result.DisplayName = id
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listDBClusterParameterGroups(ctx context.Context, conn *docdb.Client, input *docdb.DescribeDBClusterParameterGroupsInput) iter.Seq2[awstypes.DBClusterParameterGroup, error] {
+	return func(yield func(awstypes.DBClusterParameterGroup, error) bool) {
+		pages := docdb.NewDescribeDBClusterParameterGroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.DBClusterParameterGroup{}, fmt.Errorf("listing DocumentDB Cluster Parameter Groups: %w", err))
+				return
+			}
+
+			for _, v := range page.DBClusterParameterGroups {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
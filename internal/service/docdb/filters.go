@@ -0,0 +1,21 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package docdb
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/docdb/types"
+)
+
+// appendListFilter appends an API filter for the specified value, if any.
+func appendListFilter(filters []awstypes.Filter, name, value string) []awstypes.Filter {
+	if value == "" {
+		return filters
+	}
+
+	return append(filters, awstypes.Filter{
+		Name:   aws.String(name),
+		Values: []string{value},
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package docdb
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/docdb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/docdb/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_docdb_subnet_group")
+func newSubnetGroupResourceAsListResource() inttypes.ListResourceForSDK {
+	l := subnetGroupListResource{}
+	l.SetResourceSchema(resourceSubnetGroup())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &subnetGroupListResource{}
+
+type subnetGroupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type subnetGroupListResourceModel struct {
+	framework.WithRegionModel
+	Tags tftags.Map `tfsdk:"tags"`
+}
+
+func (l *subnetGroupListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrTags: framework.ListTagsFilterAttribute(),
+		},
+	}
+}
+
+func (l *subnetGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.DocDBClient(ctx)
+
+	var query subnetGroupListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	// DB subnet groups are shared by Amazon RDS, Amazon DocumentDB and Amazon Neptune and carry no engine,
+	// so there is nothing to filter on: all DB subnet groups in the Region are listed.
+	var input docdb.DescribeDBSubnetGroupsInput
+
+	tflog.Info(ctx, "Listing DocumentDB Subnet Groups")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for group, err := range listDBSubnetGroups(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// The default DB subnet group cannot be managed.
+			if aws.ToString(group.DBSubnetGroupName) == "default" {
+				continue
+			}
+
+			if len(query.Tags.Elements()) > 0 {
+				tags, err := listTags(ctx, conn, aws.ToString(group.DBSubnetGroupArn))
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("listing tags for DocumentDB Subnet Group (%s): %w", aws.ToString(group.DBSubnetGroupArn), err))
+					yield(result)
+					return
+				}
+
+				if !framework.MatchesTagsFilter(ctx, query.Tags, tags) {
+					continue
+				}
+			}
+
+			id := aws.ToString(group.DBSubnetGroupName)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading DocumentDB Subnet Group")
+				if diags := resourceSubnetGroupRead(ctx, rd, awsClient); diags.HasError() {
+					tflog.Error(ctx, "Reading DocumentDB Subnet Group", map[string]any{
+						"diags": sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				if rd.Id() == "" {
+					continue
+				}
+			}
+
+			result.DisplayName = id
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listDBSubnetGroups(ctx context.Context, conn *docdb.Client, input *docdb.DescribeDBSubnetGroupsInput) iter.Seq2[awstypes.DBSubnetGroup, error] {
+	return func(yield func(awstypes.DBSubnetGroup, error) bool) {
+		pages := docdb.NewDescribeDBSubnetGroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.DBSubnetGroup{}, fmt.Errorf("listing DocumentDB Subnet Groups: %w", err))
+				return
+			}
+
+			for _, v := range page.DBSubnetGroups {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -25,6 +25,9 @@ import (
 )
 
 // @SDKResource("aws_dynamodb_global_table", name="Global Table")
+// @IdentityAttribute("name")
+// @Testing(preCheck="testAccPreCheckGlobalTable")
+// @Testing(preIdentityVersion="v6.42.0")
 func resourceGlobalTable() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGlobalTableCreate,
@@ -32,10 +35,6 @@ func resourceGlobalTable() *schema.Resource {
 		UpdateWithoutTimeout: resourceGlobalTableUpdate,
 		DeleteWithoutTimeout: resourceGlobalTableDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
 			Update: schema.DefaultTimeout(1 * time.Minute),
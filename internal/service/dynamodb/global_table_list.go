@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_dynamodb_global_table")
+func newGlobalTableResourceAsListResource() inttypes.ListResourceForSDK {
+	l := globalTableListResource{}
+	l.SetResourceSchema(resourceGlobalTable())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &globalTableListResource{}
+
+type globalTableListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type globalTableListResourceModel struct {
+	framework.WithRegionModel
+	NamePrefix types.String `tfsdk:"name_prefix"`
+}
+
+func (l *globalTableListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: framework.ListNamePrefixFilterAttribute(),
+		},
+	}
+}
+
+func (l *globalTableListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.DynamoDBClient(ctx)
+
+	var query globalTableListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	// Only list global tables having a replica in the configured Region.
+	input := dynamodb.ListGlobalTablesInput{
+		RegionName: aws.String(awsClient.Region(ctx)),
+	}
+
+	tflog.Info(ctx, "Listing DynamoDB Global Tables")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for globalTable, err := range listGlobalTables(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			name := aws.ToString(globalTable.GlobalTableName)
+			if !framework.MatchesNamePrefixFilter(query.NamePrefix, name) {
+				continue
+			}
+
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), name)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(name)
+			rd.Set(names.AttrName, name)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading DynamoDB Global Table")
+				if diags := resourceGlobalTableRead(ctx, rd, awsClient); diags.HasError() {
+					tflog.Error(ctx, "Reading DynamoDB Global Table", map[string]any{
+						"diags": sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				if rd.Id() == "" {
+					continue
+				}
+			}
+
+			result.DisplayName = name
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listGlobalTables(ctx context.Context, conn *dynamodb.Client, input *dynamodb.ListGlobalTablesInput) iter.Seq2[awstypes.GlobalTable, error] {
+	return func(yield func(awstypes.GlobalTable, error) bool) {
+		for {
+			output, err := conn.ListGlobalTables(ctx, input)
+			if err != nil {
+				yield(awstypes.GlobalTable{}, fmt.Errorf("listing DynamoDB Global Tables: %w", err))
+				return
+			}
+
+			for _, v := range output.GlobalTables {
+				if !yield(v, nil) {
+					return
+				}
+			}
+
+			if output.LastEvaluatedGlobalTableName == nil {
+				return
+			}
+
+			input.ExclusiveStartGlobalTableName = output.LastEvaluatedGlobalTableName
+		}
+	}
+}
@@ -0,0 +1,44 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_dynamodb_global_table" "test" {
+  count = var.resource_count
+
+  depends_on = [aws_dynamodb_table.test]
+
+  name = "${var.rName}-${count.index}"
+
+  replica {
+    region_name = data.aws_region.current.region
+  }
+}
+
+data "aws_region" "current" {}
+
+resource "aws_dynamodb_table" "test" {
+  count = var.resource_count
+
+  name             = "${var.rName}-${count.index}"
+  hash_key         = "myAttribute"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+  read_capacity    = 1
+  write_capacity   = 1
+
+  attribute {
+    name = "myAttribute"
+    type = "S"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "resource_count" {
+  description = "Number of resources to create"
+  type        = number
+  nullable    = false
+}
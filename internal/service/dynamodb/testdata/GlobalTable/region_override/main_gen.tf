@@ -0,0 +1,46 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_dynamodb_global_table" "test" {
+  region = var.region
+
+  depends_on = [aws_dynamodb_table.test]
+
+  name = var.rName
+
+  replica {
+    region_name = data.aws_region.current.region
+  }
+}
+
+data "aws_region" "current" {
+  region = var.region
+}
+
+resource "aws_dynamodb_table" "test" {
+  region = var.region
+
+  hash_key         = "myAttribute"
+  name             = var.rName
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+  read_capacity    = 1
+  write_capacity   = 1
+
+  attribute {
+    name = "myAttribute"
+    type = "S"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,259 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_ec2_create_image, name="Create Image")
+func newCreateImageAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &createImageAction{}, nil
+}
+
+var (
+	_ action.Action = (*createImageAction)(nil)
+)
+
+type createImageAction struct {
+	framework.ActionWithModel[createImageModel]
+}
+
+type createImageModel struct {
+	framework.WithRegionModel
+	Description types.String        `tfsdk:"description"`
+	InstanceID  types.String        `tfsdk:"instance_id"`
+	Name        types.String        `tfsdk:"name"`
+	NoReboot    types.Bool          `tfsdk:"no_reboot"`
+	Tags        fwtypes.MapOfString `tfsdk:"tags"`
+	Timeout     types.Int64         `tfsdk:"timeout"`
+}
+
+func (a *createImageAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates an Amazon Machine Image (AMI) from an EC2 instance. This action will create the image and wait for it to become available, reporting snapshot progress.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Description: "A description for the new image",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			names.AttrInstanceID: instanceIDActionAttribute("The ID of the EC2 instance to create the image from"),
+			names.AttrName: schema.StringAttribute{
+				Description: "A name for the new image",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 128),
+				},
+			},
+			"no_reboot": schema.BoolAttribute{
+				Description: "Whether to create the image without shutting down and rebooting the instance. File system integrity on the created image can't be guaranteed.",
+				Optional:    true,
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Description: "Tags to apply to the image and its snapshots",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the image to become available (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(14400),
+				},
+			},
+		},
+	}
+}
+
+func (a *createImageAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config createImageModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().EC2Client(ctx)
+
+	instanceID := fwflex.StringValueFromFramework(ctx, config.InstanceID)
+	name := fwflex.StringValueFromFramework(ctx, config.Name)
+	timeout := fwactions.TimeoutOr(config.Timeout, 60*time.Minute)
+
+	tflog.Info(ctx, "Starting EC2 create image action", map[string]any{
+		names.AttrInstanceID: instanceID,
+		names.AttrName:       name,
+		names.AttrTimeout:    timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Creating image %s from EC2 instance %s...", name, instanceID)
+
+	input := ec2.CreateImageInput{
+		Description: fwflex.StringFromFramework(ctx, config.Description),
+		InstanceId:  aws.String(instanceID),
+		Name:        aws.String(name),
+		NoReboot:    fwflex.BoolFromFramework(ctx, config.NoReboot),
+	}
+
+	if tags := tftags.New(ctx, fwflex.ExpandFrameworkStringValueMap(ctx, config.Tags)); len(tags) > 0 {
+		input.TagSpecifications = append(input.TagSpecifications, tagSpecificationsFromKeyValue(tags, string(awstypes.ResourceTypeImage))...)
+		input.TagSpecifications = append(input.TagSpecifications, tagSpecificationsFromKeyValue(tags, string(awstypes.ResourceTypeSnapshot))...)
+	}
+
+	output, err := conn.CreateImage(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create Image",
+			fmt.Sprintf("Could not create image from EC2 instance %s: %s", instanceID, err),
+		)
+		return
+	}
+
+	imageID := aws.ToString(output.ImageId)
+	cb(ctx, "Image %s created, waiting for image to become available...", imageID)
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*imageProgress], error) {
+		progress, ferr := findImageProgressByID(ctx, conn, imageID)
+		// The new image may not be visible to DescribeImages immediately.
+		if retry.NotFound(ferr) {
+			return actionwait.FetchResult[*imageProgress]{Status: actionwait.Status(awstypes.ImageStatePending)}, nil
+		}
+		if ferr != nil {
+			return actionwait.FetchResult[*imageProgress]{}, fmt.Errorf("describing image: %w", ferr)
+		}
+		return actionwait.FetchResult[*imageProgress]{Status: actionwait.Status(progress.image.State), Value: progress}, nil
+	}, actionwait.Options[*imageProgress]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(instanceActionPollInterval),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{actionwait.Status(awstypes.ImageStateAvailable)},
+		TransitionalStates: []actionwait.Status{actionwait.Status(awstypes.ImageStatePending)},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.ImageStateDeregistered),
+			actionwait.Status(awstypes.ImageStateError),
+			actionwait.Status(awstypes.ImageStateFailed),
+			actionwait.Status(awstypes.ImageStateInvalid),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if progress, ok := fr.Value.(*imageProgress); ok && len(progress.snapshots) > 0 {
+				cb(ctx, "Image %s is currently in state '%s', snapshot progress: %s", imageID, fr.Status, progress.snapshotProgress())
+			} else {
+				cb(ctx, "Image %s is currently in state '%s', continuing to wait for '%s'...", imageID, fr.Status, awstypes.ImageStateAvailable)
+			}
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Image",
+				fmt.Sprintf("Image %s did not become available within %s", imageID, timeout),
+			)
+		} else if errors.As(err, &failureErr) {
+			detail := fmt.Sprintf("Image %s entered state: %s", imageID, failureErr.Status)
+			if v := result.Value; v != nil && v.image.StateReason != nil && aws.ToString(v.image.StateReason.Message) != "" {
+				detail += "\n\n" + aws.ToString(v.image.StateReason.Message)
+			}
+			resp.Diagnostics.AddError("Image Creation Failed", detail)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected Image State", err.Error())
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Image",
+				fmt.Sprintf("Error while waiting for image %s to become available: %s", imageID, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Image %s is available", imageID)
+
+	tflog.Info(ctx, "EC2 create image action completed successfully", map[string]any{
+		names.AttrInstanceID: instanceID,
+		"image_id":           imageID,
+	})
+}
+
+// imageProgress captures an image together with the snapshots backing its EBS block device mappings.
+type imageProgress struct {
+	image     *awstypes.Image
+	snapshots []awstypes.Snapshot
+}
+
+// snapshotProgress summarizes the progress of each snapshot, e.g. "snap-0123 (45%)".
+func (p *imageProgress) snapshotProgress() string {
+	progress := make([]string, 0, len(p.snapshots))
+
+	for _, v := range p.snapshots {
+		progress = append(progress, fmt.Sprintf("%s (%s)", aws.ToString(v.SnapshotId), aws.ToString(v.Progress)))
+	}
+
+	return strings.Join(progress, ", ")
+}
+
+func findImageProgressByID(ctx context.Context, conn *ec2.Client, id string) (*imageProgress, error) {
+	image, err := findImageByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &imageProgress{
+		image: image,
+	}
+
+	var snapshotIDs []string
+	for _, v := range image.BlockDeviceMappings {
+		if v.Ebs != nil && v.Ebs.SnapshotId != nil {
+			snapshotIDs = append(snapshotIDs, aws.ToString(v.Ebs.SnapshotId))
+		}
+	}
+
+	// Snapshot IDs are only reported once the snapshots have been started.
+	if len(snapshotIDs) == 0 {
+		return output, nil
+	}
+
+	input := ec2.DescribeSnapshotsInput{
+		SnapshotIds: snapshotIDs,
+	}
+
+	output.snapshots, err = findSnapshots(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
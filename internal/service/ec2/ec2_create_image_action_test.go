@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2CreateImageAction_trigger(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EC2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateImageActionConfig_trigger(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExistsLocal(ctx, t, resourceName, &v),
+					testAccCheckCreateImageActionImageAndDeregister(ctx, t, rName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckCreateImageActionImageAndDeregister verifies that the action created an available, tagged image
+// and then deregisters it and deletes its snapshots, as the image is not managed by Terraform.
+func testAccCheckCreateImageActionImageAndDeregister(ctx context.Context, t *testing.T, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).EC2Client(ctx)
+
+		input := ec2.DescribeImagesInput{
+			Filters: []awstypes.Filter{
+				{
+					Name:   aws.String(names.AttrName),
+					Values: []string{name},
+				},
+			},
+			Owners: []string{"self"},
+		}
+
+		images, err := tfec2.FindImages(ctx, conn, &input)
+		if err != nil {
+			return err
+		}
+
+		if len(images) != 1 {
+			return fmt.Errorf("expected 1 image named %s, got %d", name, len(images))
+		}
+
+		image := images[0]
+		imageID := aws.ToString(image.ImageId)
+
+		defer func() {
+			input := ec2.DeregisterImageInput{
+				DeleteAssociatedSnapshots: aws.Bool(true),
+				ImageId:                   aws.String(imageID),
+			}
+
+			if _, err := conn.DeregisterImage(ctx, &input); err != nil {
+				t.Errorf("deregistering EC2 AMI (%s): %s", imageID, err)
+			}
+		}()
+
+		if image.State != awstypes.ImageStateAvailable {
+			return fmt.Errorf("expected EC2 AMI (%s) state %s, got %s", imageID, awstypes.ImageStateAvailable, image.State)
+		}
+
+		for _, v := range image.Tags {
+			if aws.ToString(v.Key) == "Name" && aws.ToString(v.Value) == name {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("expected EC2 AMI (%s) to be tagged Name=%s", imageID, name)
+	}
+}
+
+func testAccCreateImageActionConfig_trigger(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		acctest.AvailableEC2InstanceTypeForAvailabilityZone("data.aws_availability_zones.available.names[0]", "t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+action "aws_ec2_create_image" "test" {
+  config {
+    instance_id = aws_instance.test.id
+    name        = %[1]q
+    description = "Created by aws_ec2_create_image"
+    no_reboot   = true
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ec2_create_image.test]
+    }
+  }
+
+  depends_on = [aws_instance.test]
+}
+`, rName))
+}
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_ec2_hibernate_instance, name="Hibernate Instance")
+func newHibernateInstanceAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &hibernateInstanceAction{}, nil
+}
+
+var (
+	_ action.Action = (*hibernateInstanceAction)(nil)
+)
+
+type hibernateInstanceAction struct {
+	framework.ActionWithModel[hibernateInstanceModel]
+}
+
+type hibernateInstanceModel struct {
+	framework.WithRegionModel
+	InstanceID types.String `tfsdk:"instance_id"`
+	Timeout    types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *hibernateInstanceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Hibernates an EC2 instance. This action will hibernate an instance that has hibernation enabled and wait for it to reach the stopped state.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrInstanceID: instanceIDActionAttribute("The ID of the EC2 instance to hibernate"),
+			names.AttrTimeout:    instanceActionTimeoutAttribute("Timeout in seconds to wait for the instance to hibernate (default: 600)"),
+		},
+	}
+}
+
+func (a *hibernateInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config hibernateInstanceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().EC2Client(ctx)
+
+	instanceID := fwflex.StringValueFromFramework(ctx, config.InstanceID)
+	timeout := fwactions.TimeoutOr(config.Timeout, 600*time.Second)
+
+	tflog.Info(ctx, "Starting EC2 hibernate instance action", map[string]any{
+		names.AttrInstanceID: instanceID,
+		names.AttrTimeout:    timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting hibernate operation for EC2 instance %s...", instanceID)
+
+	instance := findInstanceForAction(ctx, conn, instanceID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := instance.HibernationOptions; v == nil || !aws.ToBool(v.Configured) {
+		resp.Diagnostics.AddError(
+			"Cannot Hibernate Instance",
+			fmt.Sprintf("EC2 instance %s does not have hibernation enabled. Hibernation must be enabled when the instance is launched, e.g. with the aws_instance hibernation argument.", instanceID),
+		)
+		return
+	}
+
+	currentState := instance.State.Name
+	tflog.Debug(ctx, "Current instance state", map[string]any{
+		names.AttrInstanceID: instanceID,
+		names.AttrState:      currentState,
+	})
+
+	if currentState == awstypes.InstanceStateNameStopped {
+		cb(ctx, "EC2 instance %s is already stopped", instanceID)
+		tflog.Info(ctx, "Instance already stopped", map[string]any{
+			names.AttrInstanceID: instanceID,
+		})
+		return
+	}
+
+	// Unlike a regular stop, hibernation can only be requested while the instance is running.
+	if currentState != awstypes.InstanceStateNameRunning {
+		resp.Diagnostics.AddError(
+			"Cannot Hibernate Instance",
+			fmt.Sprintf("EC2 instance %s is in state '%s' and cannot be hibernated. Instance must be in 'running' state.", instanceID, currentState),
+		)
+		return
+	}
+
+	cb(ctx, "Sending hibernate command to EC2 instance %s...", instanceID)
+
+	input := ec2.StopInstancesInput{
+		Hibernate:   aws.Bool(true),
+		InstanceIds: []string{instanceID},
+	}
+
+	_, err := conn.StopInstances(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Hibernate Instance",
+			fmt.Sprintf("Could not hibernate EC2 instance %s: %s", instanceID, err),
+		)
+		return
+	}
+
+	cb(ctx, "Hibernate command sent to EC2 instance %s, waiting for instance to stop...", instanceID)
+
+	err = waitInstanceStateForAction(ctx, conn, instanceID, awstypes.InstanceStateNameStopped, []awstypes.InstanceStateName{
+		awstypes.InstanceStateNameRunning,
+		awstypes.InstanceStateNameStopping,
+	}, timeout, cb)
+	if err != nil {
+		addInstanceActionWaitError(&resp.Diagnostics, err, instanceID, "Hibernate", timeout)
+		return
+	}
+
+	cb(ctx, "EC2 instance %s has been successfully hibernated", instanceID)
+
+	tflog.Info(ctx, "EC2 hibernate instance action completed successfully", map[string]any{
+		names.AttrInstanceID: instanceID,
+	})
+}
@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+)
+
+// instanceActionPollInterval defines polling cadence for the EC2 instance actions.
+// A fixed interval is used since EC2 instance state transitions are predictable and
+// relatively quick - consistent polling every 10s is optimal for these operations.
+const instanceActionPollInterval = 10 * time.Second
+
+// instanceIDActionAttribute returns the schema for an action's instance_id argument.
+func instanceIDActionAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexache.MustCompile(`^i-[0-9a-f]{8,17}$`),
+				"must be a valid EC2 instance ID (e.g., i-1234567890abcdef0)",
+			),
+		},
+	}
+}
+
+// instanceActionTimeoutAttribute returns the schema for an instance action's timeout argument.
+func instanceActionTimeoutAttribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: description,
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(30),
+			int64validator.AtMost(3600),
+		},
+	}
+}
+
+// findInstanceForAction returns the instance, adding an error diagnostic if it cannot be described.
+func findInstanceForAction(ctx context.Context, conn *ec2.Client, instanceID string, diags *diag.Diagnostics) *awstypes.Instance {
+	instance, err := findInstanceByID(ctx, conn, instanceID)
+	if retry.NotFound(err) {
+		diags.AddError(
+			"Instance Not Found",
+			fmt.Sprintf("EC2 instance %s was not found", instanceID),
+		)
+		return nil
+	}
+	if err != nil {
+		diags.AddError(
+			"Failed to Describe Instance",
+			fmt.Sprintf("Could not describe EC2 instance %s: %s", instanceID, err),
+		)
+		return nil
+	}
+
+	return instance
+}
+
+// waitInstanceStateForAction polls the instance until it reaches the target state, sending progress updates.
+func waitInstanceStateForAction(ctx context.Context, conn *ec2.Client, instanceID string, target awstypes.InstanceStateName, transitional []awstypes.InstanceStateName, timeout time.Duration, cb fwactions.SendProgressFunc) error {
+	transitionalStates := make([]actionwait.Status, 0, len(transitional))
+	for _, v := range transitional {
+		transitionalStates = append(transitionalStates, actionwait.Status(v))
+	}
+
+	_, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		instance, derr := findInstanceByID(ctx, conn, instanceID)
+		if derr != nil {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("describing instance: %w", derr)
+		}
+		state := string(instance.State.Name)
+		return actionwait.FetchResult[struct{}]{Status: actionwait.Status(state)}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(instanceActionPollInterval),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{actionwait.Status(target)},
+		TransitionalStates: transitionalStates,
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "EC2 instance %s is currently in state '%s', continuing to wait for '%s'...", instanceID, fr.Status, target)
+		},
+	})
+
+	return err
+}
+
+// addInstanceActionWaitError adds the error diagnostic for a failed wait, e.g. verb "Stop".
+func addInstanceActionWaitError(diags *diag.Diagnostics, err error, instanceID, verb string, timeout time.Duration) {
+	lowerVerb := strings.ToLower(verb)
+
+	var timeoutErr *actionwait.TimeoutError
+	var unexpectedErr *actionwait.UnexpectedStateError
+	if errors.As(err, &timeoutErr) {
+		diags.AddError(
+			fmt.Sprintf("Timeout Waiting for Instance to %s", verb),
+			fmt.Sprintf("EC2 instance %s did not %s within %s: %s", instanceID, lowerVerb, timeout, err),
+		)
+	} else if errors.As(err, &unexpectedErr) {
+		diags.AddError(
+			"Unexpected Instance State",
+			fmt.Sprintf("EC2 instance %s entered unexpected state while waiting to %s: %s", instanceID, lowerVerb, err),
+		)
+	} else {
+		diags.AddError(
+			fmt.Sprintf("Error Waiting for Instance to %s", verb),
+			fmt.Sprintf("Error while waiting for EC2 instance %s to %s: %s", instanceID, lowerVerb, err),
+		)
+	}
+}
@@ -58,21 +58,24 @@ func TestAccEC2Instance_List_basic(t *testing.T) {
 				ConfigDirectory: config.StaticDirectory("testdata/Instance/list_basic/"),
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_instance.test", map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
-						names.AttrID:        tfknownvalue.StringPtrExact(&id1),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						names.AttrID:         tfknownvalue.StringPtrExact(&id1),
 					}),
 
 					querycheck.ExpectIdentity("aws_instance.test", map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
-						names.AttrID:        tfknownvalue.StringPtrExact(&id2),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						names.AttrID:         tfknownvalue.StringPtrExact(&id2),
 					}),
 
 					querycheck.ExpectIdentity("aws_instance.test", map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
-						names.AttrID:        tfknownvalue.StringPtrExact(&id3),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						names.AttrID:         tfknownvalue.StringPtrExact(&id3),
 					}),
 				},
 			},
@@ -125,21 +128,24 @@ func TestAccEC2Instance_List_regionOverride(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_instance.test", map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.AlternateRegion()),
-						names.AttrID:        tfknownvalue.StringPtrExact(&id1),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.AlternateRegion()),
+						names.AttrID:         tfknownvalue.StringPtrExact(&id1),
 					}),
 
 					querycheck.ExpectIdentity("aws_instance.test", map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.AlternateRegion()),
-						names.AttrID:        tfknownvalue.StringPtrExact(&id2),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.AlternateRegion()),
+						names.AttrID:         tfknownvalue.StringPtrExact(&id2),
 					}),
 
 					querycheck.ExpectIdentity("aws_instance.test", map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.AlternateRegion()),
-						names.AttrID:        tfknownvalue.StringPtrExact(&id3),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.AlternateRegion()),
+						names.AttrID:         tfknownvalue.StringPtrExact(&id3),
 					}),
 				},
 			},
@@ -187,15 +193,17 @@ func TestAccEC2Instance_List_filtered(t *testing.T) {
 				ConfigDirectory: config.StaticDirectory("testdata/Instance/list_filtered/"),
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_instance.test", map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
-						names.AttrID:        tfknownvalue.StringPtrExact(&id1),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						names.AttrID:         tfknownvalue.StringPtrExact(&id1),
 					}),
 
 					querycheck.ExpectIdentity("aws_instance.test", map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
-						names.AttrID:        tfknownvalue.StringPtrExact(&id2),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						names.AttrID:         tfknownvalue.StringPtrExact(&id2),
 					}),
 				},
 			},
@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// instanceRebootSettleTime is the longest the reboot instance action waits for the instance status checks
+// to show that the instance is rebooting. EC2 evaluates status checks about once a minute.
+const instanceRebootSettleTime = 2 * time.Minute
+
+// @Action(aws_ec2_reboot_instance, name="Reboot Instance")
+func newRebootInstanceAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &rebootInstanceAction{}, nil
+}
+
+var (
+	_ action.Action = (*rebootInstanceAction)(nil)
+)
+
+type rebootInstanceAction struct {
+	framework.ActionWithModel[rebootInstanceModel]
+}
+
+type rebootInstanceModel struct {
+	framework.WithRegionModel
+	InstanceID types.String `tfsdk:"instance_id"`
+	Timeout    types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *rebootInstanceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reboots an EC2 instance. This action will request a reboot of a running instance and wait for its instance status checks to pass.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrInstanceID: instanceIDActionAttribute("The ID of the EC2 instance to reboot"),
+			names.AttrTimeout:    instanceActionTimeoutAttribute("Timeout in seconds to wait for the reboot to begin and the instance status checks to pass (default: 600)"),
+		},
+	}
+}
+
+func (a *rebootInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rebootInstanceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().EC2Client(ctx)
+
+	instanceID := fwflex.StringValueFromFramework(ctx, config.InstanceID)
+	timeout := fwactions.TimeoutOr(config.Timeout, 600*time.Second)
+
+	tflog.Info(ctx, "Starting EC2 reboot instance action", map[string]any{
+		names.AttrInstanceID: instanceID,
+		names.AttrTimeout:    timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting reboot operation for EC2 instance %s...", instanceID)
+
+	instance := findInstanceForAction(ctx, conn, instanceID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if currentState := instance.State.Name; currentState != awstypes.InstanceStateNameRunning {
+		resp.Diagnostics.AddError(
+			"Cannot Reboot Instance",
+			fmt.Sprintf("EC2 instance %s is in state '%s' and cannot be rebooted. Instance must be in 'running' state.", instanceID, currentState),
+		)
+		return
+	}
+
+	cb(ctx, "Sending reboot command to EC2 instance %s...", instanceID)
+
+	input := ec2.RebootInstancesInput{
+		InstanceIds: []string{instanceID},
+	}
+
+	_, err := conn.RebootInstances(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Reboot Instance",
+			fmt.Sprintf("Could not reboot EC2 instance %s: %s", instanceID, err),
+		)
+		return
+	}
+
+	// A reboot does not change the instance state, so the instance status checks are used instead.
+	// Right after RebootInstances the checks usually still report "ok", so first wait for them to report
+	// that the operating system is restarting, for at most instanceRebootSettleTime or half the timeout, and then for them to pass.
+	fetch := func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		status, err := findInstanceStatusByID(ctx, conn, instanceID)
+		if err != nil {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("describing instance status: %w", err)
+		}
+		if status.InstanceStatus == nil {
+			return actionwait.FetchResult[struct{}]{Status: actionwait.Status(awstypes.SummaryStatusInsufficientData)}, nil
+		}
+		return actionwait.FetchResult[struct{}]{Status: actionwait.Status(status.InstanceStatus.Status)}, nil
+	}
+	notOKStates := []actionwait.Status{
+		actionwait.Status(awstypes.SummaryStatusImpaired),
+		actionwait.Status(awstypes.SummaryStatusInitializing),
+		actionwait.Status(awstypes.SummaryStatusInsufficientData),
+	}
+	start := time.Now()
+
+	cb(ctx, "Reboot command sent to EC2 instance %s, waiting for the reboot to begin...", instanceID)
+
+	_, err = actionwait.WaitForStatus(ctx, fetch, actionwait.Options[struct{}]{
+		Timeout:            min(instanceRebootSettleTime, timeout/2),
+		Interval:           actionwait.FixedInterval(instanceActionPollInterval),
+		SuccessStates:      notOKStates,
+		TransitionalStates: []actionwait.Status{actionwait.Status(awstypes.SummaryStatusOk)},
+	})
+	// Fast reboots may complete between status check evaluations, so not seeing the reboot isn't an error.
+	if err != nil && !actionwait.IsTimeout(err) {
+		addInstanceActionWaitError(&resp.Diagnostics, err, instanceID, "Reboot", timeout)
+		return
+	}
+	rebootObserved := err == nil
+
+	if rebootObserved {
+		cb(ctx, "EC2 instance %s is rebooting, waiting for instance status checks to pass...", instanceID)
+	} else {
+		cb(ctx, "EC2 instance %s status checks did not change after %s, waiting for instance status checks to pass...", instanceID, time.Since(start).Round(time.Second))
+	}
+
+	_, err = actionwait.WaitForStatus(ctx, fetch, actionwait.Options[struct{}]{
+		Timeout:            timeout - time.Since(start),
+		Interval:           actionwait.FixedInterval(instanceActionPollInterval),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{actionwait.Status(awstypes.SummaryStatusOk)},
+		TransitionalStates: notOKStates,
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "EC2 instance %s status checks are currently '%s', continuing to wait for '%s'...", instanceID, fr.Status, awstypes.SummaryStatusOk)
+		},
+	})
+	if err != nil {
+		addInstanceActionWaitError(&resp.Diagnostics, err, instanceID, "Reboot", timeout)
+		return
+	}
+
+	if !rebootObserved {
+		cb(ctx, "EC2 instance %s status checks are passing; the reboot was not observed in the instance status checks", instanceID)
+
+		tflog.Info(ctx, "EC2 reboot instance action completed without observing the reboot", map[string]any{
+			names.AttrInstanceID: instanceID,
+		})
+		return
+	}
+
+	cb(ctx, "EC2 instance %s has been successfully rebooted", instanceID)
+
+	tflog.Info(ctx, "EC2 reboot instance action completed successfully", map[string]any{
+		names.AttrInstanceID: instanceID,
+	})
+}
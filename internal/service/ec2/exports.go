@@ -8,20 +8,25 @@ var (
 	CustomFiltersBlock                                                      = customFiltersBlock
 	DeleteNetworkInterface                                                  = deleteNetworkInterface
 	DetachNetworkInterface                                                  = detachNetworkInterface
+	FindEIPs                                                                = findEIPs
 	FindImageByID                                                           = findImageByID
 	FindInstanceByID                                                        = findInstanceByID
 	FindIPAMPoolAllocationsByIPAMPoolIDAndResourceID                        = findIPAMPoolAllocationsByIPAMPoolIDAndResourceID
+	FindNATGateways                                                         = findNATGateways
 	FindNetworkInterfaces                                                   = findNetworkInterfaces
 	FindNetworkInterfacesByAttachmentInstanceOwnerIDAndDescription          = findNetworkInterfacesByAttachmentInstanceOwnerIDAndDescription
 	FindSecurityGroupByDescriptionAndVPCID                                  = findSecurityGroupByDescriptionAndVPCID
 	FindSecurityGroupByNameAndVPCID                                         = findSecurityGroupByNameAndVPCID
 	FindSecurityGroupByNameAndVPCIDAndOwnerID                               = findSecurityGroupByNameAndVPCIDAndOwnerID
+	FindSecurityGroupRulesBySecurityGroupID                                 = findSecurityGroupRulesBySecurityGroupID
 	FindSecurityGroups                                                      = findSecurityGroups
 	FindSubnetByID                                                          = findSubnetByID
+	FindSubnets                                                             = findSubnets
 	FindTransitGatewayAttachmentByID                                        = findTransitGatewayAttachmentByID
 	FindTransitGatewayAttachmentByTransitGatewayIDAndDirectConnectGatewayID = findTransitGatewayAttachmentByTransitGatewayIDAndDirectConnectGatewayID
 	FindVPCByID                                                             = findVPCByID
 	FindVPCEndpointByID                                                     = findVPCEndpointByID
+	FindVPCs                                                                = findVPCs
 	NetworkInterfaceDetachedTimeout                                         = networkInterfaceDetachedTimeout
 	NewCustomFilterListFramework                                            = newCustomFilterListFramework
 	NewFilter                                                               = newFilter
@@ -179,6 +179,7 @@ var (
 	FindIPAMResourceDiscoveryByID                               = findIPAMResourceDiscoveryByID
 	FindIPAMScopeByID                                           = findIPAMScopeByID
 	FindImageLaunchPermission                                   = findImageLaunchPermission
+	FindImages                                                  = findImages
 	FindInstanceConnectEndpointByID                             = findInstanceConnectEndpointByID
 	FindInstanceMetadataDefaults                                = findInstanceMetadataDefaults
 	FindInstanceStateByID                                       = findInstanceStateByID
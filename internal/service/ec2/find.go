@@ -573,6 +573,15 @@ func findInstanceStatuses(ctx context.Context, conn *ec2.Client, input *ec2.Desc
 	return output, nil
 }
 
+func findInstanceStatusByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.InstanceStatus, error) {
+	input := ec2.DescribeInstanceStatusInput{
+		IncludeAllInstances: aws.Bool(true),
+		InstanceIds:         []string{id},
+	}
+
+	return findInstanceStatus(ctx, conn, &input)
+}
+
 func findInstanceState(ctx context.Context, conn *ec2.Client, input *ec2.DescribeInstanceStatusInput) (*awstypes.InstanceState, error) {
 	output, err := findInstanceStatus(ctx, conn, input)
 
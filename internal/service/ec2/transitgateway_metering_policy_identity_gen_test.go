@@ -57,6 +57,7 @@ func testAccTransitGatewayTransitGatewayMeteringPolicy_Identity_basic(t *testing
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:                 knownvalue.Null(),
 						names.AttrAccountID:                  tfknownvalue.AccountID(),
 						names.AttrRegion:                     knownvalue.StringExact(acctest.Region()),
 						"transit_gateway_metering_policy_id": knownvalue.NotNull(),
@@ -134,6 +135,7 @@ func testAccTransitGatewayTransitGatewayMeteringPolicy_Identity_regionOverride(t
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:                 knownvalue.Null(),
 						names.AttrAccountID:                  tfknownvalue.AccountID(),
 						names.AttrRegion:                     knownvalue.StringExact(acctest.AlternateRegion()),
 						"transit_gateway_metering_policy_id": knownvalue.NotNull(),
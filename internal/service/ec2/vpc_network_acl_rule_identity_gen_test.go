@@ -48,12 +48,13 @@ func TestAccVPCNetworkACLRule_Identity_basic(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
-						"network_acl_id":    knownvalue.NotNull(),
-						"egress":            knownvalue.NotNull(),
-						"rule_number":       knownvalue.NotNull(),
-						names.AttrProtocol:  knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						"network_acl_id":     knownvalue.NotNull(),
+						"egress":             knownvalue.NotNull(),
+						"rule_number":        knownvalue.NotNull(),
+						names.AttrProtocol:   knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("network_acl_id")),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("egress")),
@@ -144,12 +145,13 @@ func TestAccVPCNetworkACLRule_Identity_regionOverride(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.AlternateRegion()),
-						"network_acl_id":    knownvalue.NotNull(),
-						"egress":            knownvalue.NotNull(),
-						"rule_number":       knownvalue.NotNull(),
-						names.AttrProtocol:  knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.AlternateRegion()),
+						"network_acl_id":     knownvalue.NotNull(),
+						"egress":             knownvalue.NotNull(),
+						"rule_number":        knownvalue.NotNull(),
+						names.AttrProtocol:   knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("network_acl_id")),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("egress")),
@@ -264,12 +266,13 @@ func TestAccVPCNetworkACLRule_Identity_ExistingResource_basic(t *testing.T) {
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
-						"network_acl_id":    knownvalue.NotNull(),
-						"egress":            knownvalue.NotNull(),
-						"rule_number":       knownvalue.NotNull(),
-						names.AttrProtocol:  knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						"network_acl_id":     knownvalue.NotNull(),
+						"egress":             knownvalue.NotNull(),
+						"rule_number":        knownvalue.NotNull(),
+						names.AttrProtocol:   knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("network_acl_id")),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("egress")),
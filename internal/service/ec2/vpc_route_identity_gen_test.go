@@ -47,6 +47,7 @@ func TestAccVPCRoute_Identity_basic(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:          knownvalue.Null(),
 						names.AttrAccountID:           tfknownvalue.AccountID(),
 						names.AttrRegion:              knownvalue.StringExact(acctest.Region()),
 						"route_table_id":              knownvalue.NotNull(),
@@ -127,6 +128,7 @@ func TestAccVPCRoute_Identity_regionOverride(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:          knownvalue.Null(),
 						names.AttrAccountID:           tfknownvalue.AccountID(),
 						names.AttrRegion:              knownvalue.StringExact(acctest.AlternateRegion()),
 						"route_table_id":              knownvalue.NotNull(),
@@ -232,6 +234,7 @@ func TestAccVPCRoute_Identity_ExistingResource_basic(t *testing.T) {
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:          knownvalue.Null(),
 						names.AttrAccountID:           tfknownvalue.AccountID(),
 						names.AttrRegion:              knownvalue.StringExact(acctest.Region()),
 						"route_table_id":              knownvalue.NotNull(),
@@ -60,6 +60,7 @@ func TestAccVPCRoute_List_basic(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_route.test", map[string]knownvalue.Check{
+						names.AttrAssumeRole:          knownvalue.Null(),
 						names.AttrAccountID:           tfknownvalue.AccountID(),
 						names.AttrRegion:              knownvalue.StringExact(acctest.Region()),
 						"route_table_id":              routeTableID.ValueCheck(),
@@ -68,6 +69,7 @@ func TestAccVPCRoute_List_basic(t *testing.T) {
 						"destination_prefix_list_id":  knownvalue.Null(),
 					}),
 					querycheck.ExpectIdentity("aws_route.test", map[string]knownvalue.Check{
+						names.AttrAssumeRole:          knownvalue.Null(),
 						names.AttrAccountID:           tfknownvalue.AccountID(),
 						names.AttrRegion:              knownvalue.StringExact(acctest.Region()),
 						"route_table_id":              routeTableID.ValueCheck(),
@@ -119,6 +121,7 @@ func TestAccVPCRoute_List_ipv6Destination(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_route.test", map[string]knownvalue.Check{
+						names.AttrAssumeRole:          knownvalue.Null(),
 						names.AttrAccountID:           tfknownvalue.AccountID(),
 						names.AttrRegion:              knownvalue.StringExact(acctest.Region()),
 						"route_table_id":              routeTableID.ValueCheck(),
@@ -170,6 +173,7 @@ func TestAccVPCRoute_List_prefixListDestination(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_route.test", map[string]knownvalue.Check{
+						names.AttrAssumeRole:          knownvalue.Null(),
 						names.AttrAccountID:           tfknownvalue.AccountID(),
 						names.AttrRegion:              knownvalue.StringExact(acctest.Region()),
 						"route_table_id":              routeTableID.ValueCheck(),
@@ -226,6 +230,7 @@ func TestAccVPCRoute_List_regionOverride(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_route.test", map[string]knownvalue.Check{
+						names.AttrAssumeRole:          knownvalue.Null(),
 						names.AttrAccountID:           tfknownvalue.AccountID(),
 						names.AttrRegion:              knownvalue.StringExact(acctest.AlternateRegion()),
 						"route_table_id":              routeTableID.ValueCheck(),
@@ -234,6 +239,7 @@ func TestAccVPCRoute_List_regionOverride(t *testing.T) {
 						"destination_prefix_list_id":  knownvalue.Null(),
 					}),
 					querycheck.ExpectIdentity("aws_route.test", map[string]knownvalue.Check{
+						names.AttrAssumeRole:          knownvalue.Null(),
 						names.AttrAccountID:           tfknownvalue.AccountID(),
 						names.AttrRegion:              knownvalue.StringExact(acctest.AlternateRegion()),
 						"route_table_id":              routeTableID.ValueCheck(),
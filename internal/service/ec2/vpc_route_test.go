@@ -2324,6 +2324,7 @@ func TestAccVPCRoute_Identity_ToIPv6(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:          knownvalue.Null(),
 						names.AttrAccountID:           tfknownvalue.AccountID(),
 						names.AttrRegion:              knownvalue.StringExact(acctest.Region()),
 						"route_table_id":              knownvalue.NotNull(),
@@ -2425,6 +2426,7 @@ func TestAccVPCRoute_Identity_ToIPv6_ExistingResource(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:          knownvalue.Null(),
 						names.AttrAccountID:           tfknownvalue.AccountID(),
 						names.AttrRegion:              knownvalue.StringExact(acctest.Region()),
 						"route_table_id":              knownvalue.NotNull(),
@@ -2468,6 +2470,7 @@ func TestAccVPCRoute_Identity_ToPrefixList(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:          knownvalue.Null(),
 						names.AttrAccountID:           tfknownvalue.AccountID(),
 						names.AttrRegion:              knownvalue.StringExact(acctest.Region()),
 						"route_table_id":              knownvalue.NotNull(),
@@ -2577,6 +2580,7 @@ func TestAccVPCRoute_Identity_ToPrefixList_ExistingResource(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:          knownvalue.Null(),
 						names.AttrAccountID:           tfknownvalue.AccountID(),
 						names.AttrRegion:              knownvalue.StringExact(acctest.Region()),
 						"route_table_id":              knownvalue.NotNull(),
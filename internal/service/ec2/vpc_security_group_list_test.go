@@ -59,14 +59,16 @@ func TestAccVPCSecurityGroup_List_basic(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_security_group.test", map[string]knownvalue.Check{
-						names.AttrID:        knownvalue.NotNull(),
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrID:         knownvalue.NotNull(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
 					}),
 					querycheck.ExpectIdentity("aws_security_group.test", map[string]knownvalue.Check{
-						names.AttrID:        knownvalue.NotNull(),
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrID:         knownvalue.NotNull(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
 					}),
 				},
 			},
@@ -117,14 +119,16 @@ func TestAccVPCSecurityGroup_List_filterByGroupIDs(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_security_group.test", map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
-						names.AttrID:        tfknownvalue.StringPtrExact(&id1),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						names.AttrID:         tfknownvalue.StringPtrExact(&id1),
 					}),
 					querycheck.ExpectIdentity("aws_security_group.test", map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
-						names.AttrID:        tfknownvalue.StringPtrExact(&id2),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						names.AttrID:         tfknownvalue.StringPtrExact(&id2),
 					}),
 				},
 			},
@@ -175,14 +179,16 @@ func TestAccVPCSecurityGroup_List_filterByVPCID(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_security_group.test", map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
-						names.AttrID:        tfknownvalue.StringPtrExact(&id1),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						names.AttrID:         tfknownvalue.StringPtrExact(&id1),
 					}),
 					querycheck.ExpectIdentity("aws_security_group.test", map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    tfknownvalue.StringExact(acctest.Region()),
-						names.AttrID:        tfknownvalue.StringPtrExact(&id2),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     tfknownvalue.StringExact(acctest.Region()),
+						names.AttrID:         tfknownvalue.StringPtrExact(&id2),
 					}),
 				},
 			},
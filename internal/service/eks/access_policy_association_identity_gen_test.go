@@ -50,6 +50,7 @@ func TestAccEKSAccessPolicyAssociation_Identity_basic(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:  knownvalue.Null(),
 						names.AttrAccountID:   tfknownvalue.AccountID(),
 						names.AttrRegion:      knownvalue.StringExact(acctest.Region()),
 						names.AttrClusterName: knownvalue.NotNull(),
@@ -140,6 +141,7 @@ func TestAccEKSAccessPolicyAssociation_Identity_regionOverride(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:  knownvalue.Null(),
 						names.AttrAccountID:   tfknownvalue.AccountID(),
 						names.AttrRegion:      knownvalue.StringExact(acctest.AlternateRegion()),
 						names.AttrClusterName: knownvalue.NotNull(),
@@ -257,6 +259,7 @@ func TestAccEKSAccessPolicyAssociation_Identity_ExistingResource_basic(t *testin
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:  knownvalue.Null(),
 						names.AttrAccountID:   tfknownvalue.AccountID(),
 						names.AttrRegion:      knownvalue.StringExact(acctest.Region()),
 						names.AttrClusterName: knownvalue.NotNull(),
@@ -50,6 +50,7 @@ func TestAccEKSAddon_Identity_basic(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:  knownvalue.Null(),
 						names.AttrAccountID:   tfknownvalue.AccountID(),
 						names.AttrRegion:      knownvalue.StringExact(acctest.Region()),
 						names.AttrClusterName: knownvalue.NotNull(),
@@ -136,6 +137,7 @@ func TestAccEKSAddon_Identity_regionOverride(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:  knownvalue.Null(),
 						names.AttrAccountID:   tfknownvalue.AccountID(),
 						names.AttrRegion:      knownvalue.StringExact(acctest.AlternateRegion()),
 						names.AttrClusterName: knownvalue.NotNull(),
@@ -249,6 +251,7 @@ func TestAccEKSAddon_Identity_ExistingResource_basic(t *testing.T) {
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:  knownvalue.Null(),
 						names.AttrAccountID:   tfknownvalue.AccountID(),
 						names.AttrRegion:      knownvalue.StringExact(acctest.Region()),
 						names.AttrClusterName: knownvalue.NotNull(),
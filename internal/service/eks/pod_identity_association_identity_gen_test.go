@@ -50,6 +50,7 @@ func TestAccEKSPodIdentityAssociation_Identity_basic(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:    knownvalue.Null(),
 						names.AttrAccountID:     tfknownvalue.AccountID(),
 						names.AttrRegion:        knownvalue.StringExact(acctest.Region()),
 						names.AttrClusterName:   knownvalue.NotNull(),
@@ -138,6 +139,7 @@ func TestAccEKSPodIdentityAssociation_Identity_regionOverride(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:    knownvalue.Null(),
 						names.AttrAccountID:     tfknownvalue.AccountID(),
 						names.AttrRegion:        knownvalue.StringExact(acctest.AlternateRegion()),
 						names.AttrClusterName:   knownvalue.NotNull(),
@@ -251,6 +253,7 @@ func TestAccEKSPodIdentityAssociation_Identity_ExistingResource_basic(t *testing
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:    knownvalue.Null(),
 						names.AttrAccountID:     tfknownvalue.AccountID(),
 						names.AttrRegion:        knownvalue.StringExact(acctest.Region()),
 						names.AttrClusterName:   knownvalue.NotNull(),
@@ -2433,7 +2433,7 @@ func testAccCheckListenerRuleDestroy(ctx context.Context, t *testing.T) resource
 				continue
 			}
 
-			ctx = conns.NewResourceContext(ctx, "", "", "", rs.Primary.Attributes[names.AttrRegion])
+			ctx = conns.NewResourceContext(ctx, "", "", "", rs.Primary.Attributes[names.AttrRegion], rs.Primary.Attributes[names.AttrAssumeRole])
 			conn := acctest.ProviderMeta(ctx, t).ELBV2Client(ctx)
 
 			_, err := tfelbv2.FindListenerRuleByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
@@ -2562,7 +2562,7 @@ func testAccCheckLoadBalancerDestroy(ctx context.Context, t *testing.T) resource
 				continue
 			}
 
-			ctx = conns.NewResourceContext(ctx, "", "", "", rs.Primary.Attributes[names.AttrRegion])
+			ctx = conns.NewResourceContext(ctx, "", "", "", rs.Primary.Attributes[names.AttrRegion], rs.Primary.Attributes[names.AttrAssumeRole])
 			conn := acctest.ProviderMeta(ctx, t).ELBV2Client(ctx)
 
 			_, err := tfelbv2.FindLoadBalancerByARN(ctx, conn, rs.Primary.ID)
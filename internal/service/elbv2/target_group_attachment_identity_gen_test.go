@@ -48,6 +48,7 @@ func TestAccELBV2TargetGroupAttachment_Identity_basic(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:       knownvalue.Null(),
 						names.AttrAccountID:        tfknownvalue.AccountID(),
 						names.AttrRegion:           knownvalue.StringExact(acctest.Region()),
 						"target_group_arn":         knownvalue.NotNull(),
@@ -138,6 +139,7 @@ func TestAccELBV2TargetGroupAttachment_Identity_regionOverride(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:       knownvalue.Null(),
 						names.AttrAccountID:        tfknownvalue.AccountID(),
 						names.AttrRegion:           knownvalue.StringExact(acctest.AlternateRegion()),
 						"target_group_arn":         knownvalue.NotNull(),
@@ -254,6 +256,7 @@ func TestAccELBV2TargetGroupAttachment_Identity_ExistingResource_basic(t *testin
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:       knownvalue.Null(),
 						names.AttrAccountID:        tfknownvalue.AccountID(),
 						names.AttrRegion:           knownvalue.StringExact(acctest.Region()),
 						"target_group_arn":         knownvalue.NotNull(),
@@ -230,6 +230,7 @@ func TestAccELBV2TargetGroupAttachment_Identity_noPort(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:       knownvalue.Null(),
 						names.AttrAccountID:        tfknownvalue.AccountID(),
 						names.AttrRegion:           knownvalue.StringExact(acctest.Region()),
 						"target_group_arn":         knownvalue.NotNull(),
@@ -323,6 +324,7 @@ func TestAccELBV2TargetGroupAttachment_Identity_quic(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:       knownvalue.Null(),
 						names.AttrAccountID:        tfknownvalue.AccountID(),
 						names.AttrRegion:           knownvalue.StringExact(acctest.Region()),
 						"target_group_arn":         knownvalue.NotNull(),
@@ -425,6 +427,7 @@ func TestAccELBV2TargetGroupAttachment_Identity_quicNoPort(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:       knownvalue.Null(),
 						names.AttrAccountID:        tfknownvalue.AccountID(),
 						names.AttrRegion:           knownvalue.StringExact(acctest.Region()),
 						"target_group_arn":         knownvalue.NotNull(),
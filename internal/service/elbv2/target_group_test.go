@@ -4525,7 +4525,7 @@ func testAccCheckTargetGroupDestroy(ctx context.Context, t *testing.T) resource.
 				continue
 			}
 
-			ctx = conns.NewResourceContext(ctx, "", "", "", rs.Primary.Attributes[names.AttrRegion])
+			ctx = conns.NewResourceContext(ctx, "", "", "", rs.Primary.Attributes[names.AttrRegion], rs.Primary.Attributes[names.AttrAssumeRole])
 			conn := acctest.ProviderMeta(ctx, t).ELBV2Client(ctx)
 
 			_, err := tfelbv2.FindTargetGroupByARN(ctx, conn, rs.Primary.ID)
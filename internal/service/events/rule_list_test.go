@@ -62,14 +62,16 @@ func TestAccEventsRule_List_basic(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_cloudwatch_event_rule.test", map[string]knownvalue.Check{
-						names.AttrName:      knownvalue.StringExact(rName + "-0"),
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrName:       knownvalue.StringExact(rName + "-0"),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
 					}),
 					querycheck.ExpectIdentity("aws_cloudwatch_event_rule.test", map[string]knownvalue.Check{
-						names.AttrName:      knownvalue.StringExact(rName + "-1"),
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrName:       knownvalue.StringExact(rName + "-1"),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
 					}),
 				},
 			},
@@ -62,18 +62,20 @@ func TestAccEventsTarget_List_basic(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_cloudwatch_event_target.test", map[string]knownvalue.Check{
-						"target_id":         knownvalue.StringExact(rName + "-0"),
-						"event_bus_name":    knownvalue.StringExact("default"),
-						names.AttrRule:      knownvalue.StringExact(rName),
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"target_id":          knownvalue.StringExact(rName + "-0"),
+						"event_bus_name":     knownvalue.StringExact("default"),
+						names.AttrRule:       knownvalue.StringExact(rName),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
 					}),
 					querycheck.ExpectIdentity("aws_cloudwatch_event_target.test", map[string]knownvalue.Check{
-						"target_id":         knownvalue.StringExact(rName + "-1"),
-						"event_bus_name":    knownvalue.StringExact("default"),
-						names.AttrRule:      knownvalue.StringExact(rName),
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"target_id":          knownvalue.StringExact(rName + "-1"),
+						"event_bus_name":     knownvalue.StringExact("default"),
+						names.AttrRule:       knownvalue.StringExact(rName),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
 					}),
 				},
 			},
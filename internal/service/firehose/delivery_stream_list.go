@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package firehose
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_kinesis_firehose_delivery_stream")
+func newDeliveryStreamResourceAsListResource() inttypes.ListResourceForSDK {
+	l := deliveryStreamListResource{}
+	l.SetResourceSchema(resourceDeliveryStream())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &deliveryStreamListResource{}
+
+type deliveryStreamListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type deliveryStreamListResourceModel struct {
+	framework.WithRegionModel
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tags       tftags.Map   `tfsdk:"tags"`
+}
+
+func (l *deliveryStreamListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: framework.ListNamePrefixFilterAttribute(),
+			names.AttrTags:       framework.ListTagsFilterAttribute(),
+		},
+	}
+}
+
+func (l *deliveryStreamListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.FirehoseClient(ctx)
+
+	var query deliveryStreamListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input firehose.ListDeliveryStreamsInput
+
+	tflog.Info(ctx, "Listing Kinesis Firehose Delivery Streams")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for name, err := range listDeliveryStreams(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if !framework.MatchesNamePrefixFilter(query.NamePrefix, name) {
+				continue
+			}
+
+			if len(query.Tags.Elements()) > 0 {
+				tags, err := listTags(ctx, conn, name)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("listing tags for Kinesis Firehose Delivery Stream (%s): %w", name, err))
+					yield(result)
+					return
+				}
+
+				if !framework.MatchesTagsFilter(ctx, query.Tags, tags) {
+					continue
+				}
+			}
+
+			arn := awsClient.RegionalARN(ctx, "firehose", "deliverystream/"+name)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), arn)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(arn)
+			rd.Set(names.AttrARN, arn)
+			rd.Set(names.AttrName, name)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading Kinesis Firehose Delivery Stream")
+				if diags := resourceDeliveryStreamRead(ctx, rd, awsClient); diags.HasError() {
+					tflog.Error(ctx, "Reading Kinesis Firehose Delivery Stream", map[string]any{
+						"diags": sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				if rd.Id() == "" {
+					continue
+				}
+			}
+
+			result.DisplayName = name
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listDeliveryStreams(ctx context.Context, conn *firehose.Client, input *firehose.ListDeliveryStreamsInput) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		err := listDeliveryStreamsPages(ctx, conn, input, func(page *firehose.ListDeliveryStreamsOutput, lastPage bool) bool {
+			for _, v := range page.DeliveryStreamNames {
+				if !yield(v, nil) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			yield("", fmt.Errorf("listing Kinesis Firehose Delivery Streams: %w", err))
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package glue_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGlueStartJobRunAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckJobDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartJobRunActionConfig_basic(rName, `print("hello")`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobRunState(ctx, t, "aws_glue_job.test", awstypes.JobRunStateSucceeded),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueStartJobRunAction_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckJobDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartJobRunActionConfig_basic(rName, `raise Exception("tf-acc-test-failure")`),
+				ExpectError: regexache.MustCompile(`(?s)Job Run Failed.*tf-acc-test-failure`),
+			},
+		},
+	})
+}
+
+func testAccCheckJobRunState(ctx context.Context, t *testing.T, n string, state awstypes.JobRunState) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).GlueClient(ctx)
+
+		input := glue.GetJobRunsInput{
+			JobName: aws.String(rs.Primary.ID),
+		}
+		output, err := conn.GetJobRuns(ctx, &input)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.JobRuns {
+			if v.JobRunState == state {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Glue Job %s has no run in state %s", rs.Primary.ID, state)
+	}
+}
+
+func testAccStartJobRunActionConfig_basic(rName, script string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = "aws-glue-%[1]s"
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "script.py"
+  content = %[2]q
+}
+
+resource "aws_glue_job" "test" {
+  name         = %[1]q
+  role_arn     = aws_iam_role.test.arn
+  max_capacity = 0.0625
+
+  command {
+    name            = "pythonshell"
+    python_version  = "3.9"
+    script_location = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+
+action "aws_glue_start_job_run" "test" {
+  config {
+    job_name = aws_glue_job.test.name
+    timeout  = 1200
+
+    arguments = {
+      "--environment" = "test"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_glue_start_job_run.test]
+    }
+  }
+}
+`, rName, script))
+}
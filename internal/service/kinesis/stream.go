@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -34,6 +35,11 @@ import (
 
 // @SDKResource("aws_kinesis_stream", name="Stream")
 // @Tags(identifierAttribute="name", resourceType="Stream")
+// @IdentityAttribute("name")
+// @CustomImport
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/kinesis/types;types.StreamDescriptionSummary")
+// @Testing(importIgnore="enforce_consumer_deletion")
+// @Testing(preIdentityVersion="v6.42.0")
 func resourceStream() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStreamCreate,
@@ -543,6 +549,10 @@ func resourceStreamDelete(ctx context.Context, d *schema.ResourceData, meta any)
 }
 
 func resourceStreamImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if err := importer.Import(ctx, d, meta); err != nil {
+		return nil, err
+	}
+
 	conn := meta.(*conns.AWSClient).KinesisClient(ctx)
 
 	output, err := findStreamByName(ctx, conn, d.Id())
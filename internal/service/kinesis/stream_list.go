@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesis
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_kinesis_stream")
+func newStreamResourceAsListResource() inttypes.ListResourceForSDK {
+	l := streamListResource{}
+	l.SetResourceSchema(resourceStream())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &streamListResource{}
+
+type streamListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type streamListResourceModel struct {
+	framework.WithRegionModel
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tags       tftags.Map   `tfsdk:"tags"`
+}
+
+func (l *streamListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: framework.ListNamePrefixFilterAttribute(),
+			names.AttrTags:       framework.ListTagsFilterAttribute(),
+		},
+	}
+}
+
+func (l *streamListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.KinesisClient(ctx)
+
+	var query streamListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input kinesis.ListStreamsInput
+
+	tflog.Info(ctx, "Listing Kinesis Streams")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for summary, err := range listStreams(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			name := aws.ToString(summary.StreamName)
+			if !framework.MatchesNamePrefixFilter(query.NamePrefix, name) {
+				continue
+			}
+
+			if len(query.Tags.Elements()) > 0 {
+				tags, err := listTagsForStream(ctx, conn, name)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("listing tags for Kinesis Stream (%s): %w", name, err))
+					yield(result)
+					return
+				}
+
+				if !framework.MatchesTagsFilter(ctx, query.Tags, tags) {
+					continue
+				}
+			}
+
+			arn := aws.ToString(summary.StreamARN)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), arn)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(arn)
+			rd.Set(names.AttrName, name)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading Kinesis Stream")
+				if diags := resourceStreamRead(ctx, rd, awsClient); diags.HasError() {
+					tflog.Error(ctx, "Reading Kinesis Stream", map[string]any{
+						"diags": sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				if rd.Id() == "" {
+					continue
+				}
+			}
+
+			result.DisplayName = name
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listStreams(ctx context.Context, conn *kinesis.Client, input *kinesis.ListStreamsInput) iter.Seq2[awstypes.StreamSummary, error] {
+	return func(yield func(awstypes.StreamSummary, error) bool) {
+		pages := kinesis.NewListStreamsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.StreamSummary{}, fmt.Errorf("listing Kinesis Streams: %w", err))
+				return
+			}
+
+			for _, v := range page.StreamSummaries {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
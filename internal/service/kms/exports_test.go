@@ -21,6 +21,7 @@ var (
 	FindGrantByTwoPartKey     = findGrantByTwoPartKey
 	FindKeyByID               = findKeyByID
 	FindKeyPolicyByTwoPartKey = findKeyPolicyByTwoPartKey
+	FindOnDemandKeyRotations  = findOnDemandKeyRotations
 	GrantParseResourceID      = grantParseResourceID
 	KeyARNOrIDEqual           = keyARNOrIDEqual
 	PropagationTimeout        = propagationTimeout
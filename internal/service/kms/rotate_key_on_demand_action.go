@@ -0,0 +1,226 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// rotateKeyOnDemandPollInterval defines polling cadence for the rotate key on demand action.
+	rotateKeyOnDemandPollInterval = 10 * time.Second
+
+	keyRotationStatusInProgress = "IN_PROGRESS"
+	keyRotationStatusCompleted  = "COMPLETED"
+)
+
+// @Action(aws_kms_rotate_key_on_demand, name="Rotate Key On Demand")
+func newRotateKeyOnDemandAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &rotateKeyOnDemandAction{}, nil
+}
+
+var (
+	_ action.Action = (*rotateKeyOnDemandAction)(nil)
+)
+
+type rotateKeyOnDemandAction struct {
+	framework.ActionWithModel[rotateKeyOnDemandActionModel]
+}
+
+type rotateKeyOnDemandActionModel struct {
+	framework.WithRegionModel
+	KeyID   types.String `tfsdk:"key_id"`
+	Timeout types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *rotateKeyOnDemandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Immediately rotates the key material of a KMS key and waits for the rotation to be recorded.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrKeyID: schema.StringAttribute{
+				Description: "The key ID or key ARN of the KMS key to rotate",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2048),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the rotation to complete (default: 600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(3600),
+				},
+			},
+		},
+	}
+}
+
+func (a *rotateKeyOnDemandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rotateKeyOnDemandActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().KMSClient(ctx)
+
+	keyID := fwflex.StringValueFromFramework(ctx, config.KeyID)
+	timeout := fwactions.TimeoutOr(config.Timeout, 600*time.Second)
+
+	tflog.Info(ctx, "Starting KMS rotate key on demand action", map[string]any{
+		names.AttrKeyID:   keyID,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting on-demand rotation for KMS key %s...", keyID)
+
+	// Count the on-demand rotations already recorded so that the new one can be
+	// distinguished from earlier rotations without relying on clock skew.
+	before, err := findOnDemandKeyRotations(ctx, conn, keyID)
+	if retry.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Key Not Found",
+			fmt.Sprintf("KMS key %s was not found", keyID),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to List Key Rotations",
+			fmt.Sprintf("Could not list rotations for KMS key %s: %s", keyID, err),
+		)
+		return
+	}
+
+	input := kms.RotateKeyOnDemandInput{
+		KeyId: aws.String(keyID),
+	}
+
+	_, err = conn.RotateKeyOnDemand(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Rotate Key",
+			fmt.Sprintf("Could not start on-demand rotation for KMS key %s: %s", keyID, err),
+		)
+		return
+	}
+
+	cb(ctx, "On-demand rotation started for KMS key %s, waiting for rotation to be recorded...", keyID)
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.RotationsListEntry], error) {
+		rotations, ferr := findOnDemandKeyRotations(ctx, conn, keyID)
+		if ferr != nil {
+			return actionwait.FetchResult[*awstypes.RotationsListEntry]{}, fmt.Errorf("listing key rotations: %w", ferr)
+		}
+		if len(rotations) <= len(before) {
+			return actionwait.FetchResult[*awstypes.RotationsListEntry]{Status: keyRotationStatusInProgress}, nil
+		}
+		return actionwait.FetchResult[*awstypes.RotationsListEntry]{Status: keyRotationStatusCompleted, Value: latestKeyRotation(rotations)}, nil
+	}, actionwait.Options[*awstypes.RotationsListEntry]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(rotateKeyOnDemandPollInterval),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{keyRotationStatusCompleted},
+		TransitionalStates: []actionwait.Status{keyRotationStatusInProgress},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "On-demand rotation for KMS key %s is still in progress...", keyID)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Key Rotation",
+				fmt.Sprintf("On-demand rotation of KMS key %s was not recorded within %s: %s", keyID, timeout, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Key Rotation",
+				fmt.Sprintf("Error while waiting for on-demand rotation of KMS key %s: %s", keyID, err),
+			)
+		}
+		return
+	}
+
+	if rotation := result.Value; rotation != nil && rotation.RotationDate != nil {
+		cb(ctx, "KMS key %s was rotated successfully at %s", keyID, rotation.RotationDate.Format(time.RFC3339))
+	} else {
+		cb(ctx, "KMS key %s was rotated successfully", keyID)
+	}
+
+	tflog.Info(ctx, "KMS rotate key on demand action completed successfully", map[string]any{
+		names.AttrKeyID: keyID,
+	})
+}
+
+func findOnDemandKeyRotations(ctx context.Context, conn *kms.Client, keyID string) ([]awstypes.RotationsListEntry, error) {
+	input := kms.ListKeyRotationsInput{
+		KeyId: aws.String(keyID),
+	}
+	var output []awstypes.RotationsListEntry
+
+	pages := kms.NewListKeyRotationsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Rotations {
+			if v.RotationType == awstypes.RotationTypeOnDemand {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// latestKeyRotation returns the most recently completed rotation.
+func latestKeyRotation(rotations []awstypes.RotationsListEntry) *awstypes.RotationsListEntry {
+	var latest *awstypes.RotationsListEntry
+
+	for i := range rotations {
+		v := &rotations[i]
+		if v.RotationDate == nil {
+			continue
+		}
+		if latest == nil || v.RotationDate.After(aws.ToTime(latest.RotationDate)) {
+			latest = v
+		}
+	}
+
+	return latest
+}
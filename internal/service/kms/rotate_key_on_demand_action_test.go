@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSRotateKeyOnDemandAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_kms_key.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckKeyDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotateKeyOnDemandActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyRotatedOnDemand(ctx, t, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSRotateKeyOnDemandAction_asymmetricKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckKeyDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRotateKeyOnDemandActionConfig_asymmetricKey(rName),
+				ExpectError: regexache.MustCompile(`Failed to Rotate Key`),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyRotatedOnDemand(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).KMSClient(ctx)
+
+		rotations, err := tfkms.FindOnDemandKeyRotations(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(rotations) == 0 {
+			return fmt.Errorf("KMS Key %s has no on-demand rotations", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccRotateKeyOnDemandActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+action "aws_kms_rotate_key_on_demand" "test" {
+  config {
+    key_id = aws_kms_key.test.key_id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_kms_rotate_key_on_demand.test]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccRotateKeyOnDemandActionConfig_asymmetricKey(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description              = %[1]q
+  deletion_window_in_days  = 7
+  customer_master_key_spec = "RSA_2048"
+  key_usage                = "SIGN_VERIFY"
+}
+
+action "aws_kms_rotate_key_on_demand" "test" {
+  config {
+    key_id = aws_kms_key.test.key_id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_kms_rotate_key_on_demand.test]
+    }
+  }
+}
+`, rName)
+}
@@ -22,6 +22,16 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newRotateKeyOnDemandAction,
+			TypeName: "aws_kms_rotate_key_on_demand",
+			Name:     "Rotate Key On Demand",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+	}
+}
 func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
 	return []*inttypes.ServicePackageEphemeralResource{
 		{
@@ -50,11 +50,12 @@ func TestAccLambdaPermission_Identity_basic(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
-						"function_name":     knownvalue.NotNull(),
-						"statement_id":      knownvalue.NotNull(),
-						"qualifier":         knownvalue.Null(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						"function_name":      knownvalue.NotNull(),
+						"statement_id":       knownvalue.NotNull(),
+						"qualifier":          knownvalue.Null(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("function_name")),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("statement_id")),
@@ -139,11 +140,12 @@ func TestAccLambdaPermission_Identity_regionOverride(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.AlternateRegion()),
-						"function_name":     knownvalue.NotNull(),
-						"statement_id":      knownvalue.NotNull(),
-						"qualifier":         knownvalue.Null(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.AlternateRegion()),
+						"function_name":      knownvalue.NotNull(),
+						"statement_id":       knownvalue.NotNull(),
+						"qualifier":          knownvalue.Null(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("function_name")),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("statement_id")),
@@ -253,11 +255,12 @@ func TestAccLambdaPermission_Identity_ExistingResource_basic(t *testing.T) {
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
-						"function_name":     knownvalue.NotNull(),
-						"statement_id":      knownvalue.NotNull(),
-						"qualifier":         knownvalue.Null(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						"function_name":      knownvalue.NotNull(),
+						"statement_id":       knownvalue.NotNull(),
+						"qualifier":          knownvalue.Null(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("function_name")),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("statement_id")),
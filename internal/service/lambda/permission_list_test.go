@@ -62,18 +62,20 @@ func TestAccLambdaPermission_List_basic(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_lambda_permission.test", map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
-						"function_name":     functionName.ValueCheck(),
-						"statement_id":      statementID1.ValueCheck(),
-						"qualifier":         knownvalue.Null(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						"function_name":      functionName.ValueCheck(),
+						"statement_id":       statementID1.ValueCheck(),
+						"qualifier":          knownvalue.Null(),
 					}),
 					querycheck.ExpectIdentity("aws_lambda_permission.test", map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
-						"function_name":     functionName.ValueCheck(),
-						"statement_id":      statementID2.ValueCheck(),
-						"qualifier":         knownvalue.Null(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						"function_name":      functionName.ValueCheck(),
+						"statement_id":       statementID2.ValueCheck(),
+						"qualifier":          knownvalue.Null(),
 					}),
 				},
 			},
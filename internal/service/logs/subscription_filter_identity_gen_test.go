@@ -50,6 +50,7 @@ func TestAccLogsSubscriptionFilter_Identity_basic(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:   knownvalue.Null(),
 						names.AttrAccountID:    tfknownvalue.AccountID(),
 						names.AttrRegion:       knownvalue.StringExact(acctest.Region()),
 						names.AttrLogGroupName: knownvalue.NotNull(),
@@ -141,6 +142,7 @@ func TestAccLogsSubscriptionFilter_Identity_regionOverride(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:   knownvalue.Null(),
 						names.AttrAccountID:    tfknownvalue.AccountID(),
 						names.AttrRegion:       knownvalue.StringExact(acctest.AlternateRegion()),
 						names.AttrLogGroupName: knownvalue.NotNull(),
@@ -257,6 +259,7 @@ func TestAccLogsSubscriptionFilter_Identity_ExistingResource_basic(t *testing.T)
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:   knownvalue.Null(),
 						names.AttrAccountID:    tfknownvalue.AccountID(),
 						names.AttrRegion:       knownvalue.StringExact(acctest.Region()),
 						names.AttrLogGroupName: knownvalue.NotNull(),
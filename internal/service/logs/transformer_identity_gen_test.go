@@ -48,7 +48,8 @@ func TestAccLogsTransformer_Identity_basic(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"log_group_arn": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"log_group_arn":      knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("log_group_arn")),
 				},
@@ -131,7 +132,8 @@ func TestAccLogsTransformer_Identity_regionOverride(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"log_group_arn": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"log_group_arn":      knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("log_group_arn")),
 				},
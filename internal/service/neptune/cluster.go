@@ -53,6 +53,10 @@ const (
 
 // @SDKResource("aws_neptune_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/neptune/types;awstypes;awstypes.DBCluster")
+// @Testing(importIgnore="apply_immediately;skip_final_snapshot")
+// @Testing(preIdentityVersion="v6.42.0")
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -60,10 +64,6 @@ func resourceCluster() *schema.Resource {
 		UpdateWithoutTimeout: resourceClusterUpdate,
 		DeleteWithoutTimeout: resourceClusterDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
 			Update: schema.DefaultTimeout(120 * time.Minute),
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package neptune
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptune"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/neptune/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_neptune_parameter_group")
+func newParameterGroupResourceAsListResource() inttypes.ListResourceForSDK {
+	l := parameterGroupListResource{}
+	l.SetResourceSchema(resourceParameterGroup())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &parameterGroupListResource{}
+
+type parameterGroupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type parameterGroupListResourceModel struct {
+	framework.WithRegionModel
+	Family types.String `tfsdk:"family"`
+	Tags   tftags.Map   `tfsdk:"tags"`
+}
+
+func (l *parameterGroupListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrFamily: listschema.StringAttribute{
+				Optional:    true,
+				Description: "DB parameter group family. Only DB parameter groups in this family are listed.",
+			},
+			names.AttrTags: framework.ListTagsFilterAttribute(),
+		},
+	}
+}
+
+func (l *parameterGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.NeptuneClient(ctx)
+
+	var query parameterGroupListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input neptune.DescribeDBParameterGroupsInput
+
+	tflog.Info(ctx, "Listing Neptune Parameter Groups")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for group, err := range listDBParameterGroups(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// Default parameter groups cannot be managed.
+			if strings.HasPrefix(aws.ToString(group.DBParameterGroupName), "default.") {
+				continue
+			}
+
+			if family := aws.ToString(group.DBParameterGroupFamily); !strings.HasPrefix(family, "neptune") || (!query.Family.IsNull() && family != query.Family.ValueString()) {
+				continue
+			}
+
+			if len(query.Tags.Elements()) > 0 {
+				tags, err := listTags(ctx, conn, aws.ToString(group.DBParameterGroupArn))
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("listing tags for Neptune Parameter Group (%s): %w", aws.ToString(group.DBParameterGroupArn), err))
+					yield(result)
+					return
+				}
+
+				if !framework.MatchesTagsFilter(ctx, query.Tags, tags) {
+					continue
+				}
+			}
+
+			id := aws.ToString(group.DBParameterGroupName)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading Neptune Parameter Group")
+				if diags := resourceParameterGroupRead(ctx, rd, awsClient); diags.HasError() {
+					tflog.Error(ctx, "Reading Neptune Parameter Group", map[string]any{
+						"diags": sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				if rd.Id() == "" {
+					continue
+				}
+			}
+
+			result.DisplayName = id
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listDBParameterGroups(ctx context.Context, conn *neptune.Client, input *neptune.DescribeDBParameterGroupsInput) iter.Seq2[awstypes.DBParameterGroup, error] {
+	return func(yield func(awstypes.DBParameterGroup, error) bool) {
+		pages := neptune.NewDescribeDBParameterGroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.DBParameterGroup{}, fmt.Errorf("listing Neptune Parameter Groups: %w", err))
+				return
+			}
+
+			for _, v := range page.DBParameterGroups {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,36 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_neptune_cluster_instance" "test" {
+  count = var.resource_count
+
+  identifier         = "${var.rName}-${count.index}"
+  cluster_identifier = aws_neptune_cluster.test.id
+  instance_class     = data.aws_neptune_orderable_db_instance.test.instance_class
+}
+
+resource "aws_neptune_cluster" "test" {
+  cluster_identifier  = var.rName
+  engine              = "neptune"
+  skip_final_snapshot = true
+}
+
+data "aws_neptune_orderable_db_instance" "test" {
+  engine         = "neptune"
+  engine_version = aws_neptune_cluster.test.engine_version
+  license_model  = "amazon-license"
+
+  preferred_instance_classes = ["db.t4g.medium", "db.r6g.large", "db.r5.large", "db.t3.medium", "db.r4.large"]
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "resource_count" {
+  description = "Number of resources to create"
+  type        = number
+  nullable    = false
+}
@@ -0,0 +1,12 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_neptune_cluster_parameter_group" "test" {
+  provider = aws
+
+  config {
+    tags = {
+      Name = var.rName
+    }
+  }
+}
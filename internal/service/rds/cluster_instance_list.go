@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_rds_cluster_instance")
+func newClusterInstanceResourceAsListResource() inttypes.ListResourceForSDK {
+	l := clusterInstanceListResource{}
+	l.SetResourceSchema(resourceClusterInstance())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &clusterInstanceListResource{}
+
+type clusterInstanceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type clusterInstanceListResourceModel struct {
+	framework.WithRegionModel
+	ClusterIdentifier types.String `tfsdk:"cluster_identifier"`
+	Engine            types.String `tfsdk:"engine"`
+	Tags              tftags.Map   `tfsdk:"tags"`
+}
+
+func (l *clusterInstanceListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrClusterIdentifier: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Identifier of the DB cluster. Only DB instances belonging to this DB cluster are listed.",
+			},
+			names.AttrEngine: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Database engine. Only DB instances using this engine are listed.",
+			},
+			names.AttrTags: framework.ListTagsFilterAttribute(),
+		},
+	}
+}
+
+func (l *clusterInstanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.RDSClient(ctx)
+
+	var query clusterInstanceListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input rds.DescribeDBInstancesInput
+	input.Filters = appendListFilter(input.Filters, "db-cluster-id", query.ClusterIdentifier.ValueString())
+	input.Filters = appendListFilter(input.Filters, "engine", query.Engine.ValueString())
+
+	tflog.Info(ctx, "Listing RDS Cluster Instances")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for instance, err := range listDBInstances(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// Only Aurora DB cluster members are managed as cluster instances.
+			if instance.DBClusterIdentifier == nil || !strings.HasPrefix(aws.ToString(instance.Engine), "aurora") {
+				continue
+			}
+
+			if !framework.MatchesTagsFilter(ctx, query.Tags, keyValueTags(ctx, instance.TagList)) {
+				continue
+			}
+
+			id := aws.ToString(instance.DBInstanceIdentifier)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading RDS Cluster Instance")
+				if diags := resourceClusterInstanceRead(ctx, rd, awsClient); diags.HasError() {
+					tflog.Error(ctx, "Reading RDS Cluster Instance", map[string]any{
+						"diags": sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				if rd.Id() == "" {
+					continue
+				}
+			}
+
+			result.DisplayName = id
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
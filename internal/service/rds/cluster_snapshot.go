@@ -30,7 +30,11 @@ import (
 
 // @SDKResource("aws_db_cluster_snapshot", name="DB Cluster Snapshot")
 // @Tags(identifierAttribute="db_cluster_snapshot_arn")
+// @IdentityAttribute("id")
 // @Testing(tagsTest=false)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/rds/types;types.DBClusterSnapshot")
+// @Testing(name="ClusterSnapshot")
+// @Testing(preIdentityVersion="v6.42.0")
 func resourceClusterSnapshot() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterSnapshotCreate,
@@ -38,10 +42,6 @@ func resourceClusterSnapshot() *schema.Resource {
 		DeleteWithoutTimeout: resourceClusterSnapshotDelete,
 		UpdateWithoutTimeout: resourceClusterSnapshotUpdate,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
 		},
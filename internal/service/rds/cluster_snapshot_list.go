@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_db_cluster_snapshot")
+func newClusterSnapshotResourceAsListResource() inttypes.ListResourceForSDK {
+	l := clusterSnapshotListResource{}
+	l.SetResourceSchema(resourceClusterSnapshot())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &clusterSnapshotListResource{}
+
+type clusterSnapshotListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type clusterSnapshotListResourceModel struct {
+	framework.WithRegionModel
+	DBClusterIdentifier types.String `tfsdk:"db_cluster_identifier"`
+	Engine              types.String `tfsdk:"engine"`
+	Tags                tftags.Map   `tfsdk:"tags"`
+}
+
+func (l *clusterSnapshotListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"db_cluster_identifier": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Identifier of the DB cluster. Only DB cluster snapshots of this DB cluster are listed.",
+			},
+			names.AttrEngine: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Database engine. Only DB cluster snapshots using this engine are listed.",
+			},
+			names.AttrTags: framework.ListTagsFilterAttribute(),
+		},
+	}
+}
+
+func (l *clusterSnapshotListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.RDSClient(ctx)
+
+	var query clusterSnapshotListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input rds.DescribeDBClusterSnapshotsInput
+	input.Filters = appendListFilter(input.Filters, "db-cluster-id", query.DBClusterIdentifier.ValueString())
+	input.Filters = appendListFilter(input.Filters, "engine", query.Engine.ValueString())
+	// Only manual snapshots can be managed.
+	input.SnapshotType = aws.String("manual")
+
+	tflog.Info(ctx, "Listing RDS DB Cluster Snapshots")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for snapshot, err := range listDBClusterSnapshots(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if isNonRDSEngine(aws.ToString(snapshot.Engine)) {
+				continue
+			}
+
+			if !framework.MatchesTagsFilter(ctx, query.Tags, keyValueTags(ctx, snapshot.TagList)) {
+				continue
+			}
+
+			id := aws.ToString(snapshot.DBClusterSnapshotIdentifier)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading RDS DB Cluster Snapshot")
+				if diags := resourceClusterSnapshotRead(ctx, rd, awsClient); diags.HasError() {
+					tflog.Error(ctx, "Reading RDS DB Cluster Snapshot", map[string]any{
+						"diags": sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				if rd.Id() == "" {
+					continue
+				}
+			}
+
+			result.DisplayName = id
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listDBClusterSnapshots(ctx context.Context, conn *rds.Client, input *rds.DescribeDBClusterSnapshotsInput) iter.Seq2[awstypes.DBClusterSnapshot, error] {
+	return func(yield func(awstypes.DBClusterSnapshot, error) bool) {
+		pages := rds.NewDescribeDBClusterSnapshotsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.DBClusterSnapshot{}, fmt.Errorf("listing RDS DB Cluster Snapshots: %w", err))
+				return
+			}
+
+			for _, v := range page.DBClusterSnapshots {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
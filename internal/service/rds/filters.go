@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+// Engines managed by the Amazon DocumentDB and Amazon Neptune resource types.
+// Resources using these engines are excluded from RDS list results.
+var nonRDSEngines = []string{
+	"docdb",
+	"neptune",
+}
+
+// appendListFilter appends an RDS API filter for the specified value, if any.
+func appendListFilter(filters []awstypes.Filter, name, value string) []awstypes.Filter {
+	if value == "" {
+		return filters
+	}
+
+	return append(filters, awstypes.Filter{
+		Name:   aws.String(name),
+		Values: []string{value},
+	})
+}
+
+func isNonRDSEngine(engine string) bool {
+	return slices.Contains(nonRDSEngines, engine)
+}
+
+func isNonRDSParameterGroupFamily(family string) bool {
+	return slices.ContainsFunc(nonRDSEngines, func(engine string) bool {
+		return strings.HasPrefix(family, engine)
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_db_instance")
+func newInstanceResourceAsListResource() inttypes.ListResourceForSDK {
+	l := instanceListResource{}
+	l.SetResourceSchema(resourceInstance())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &instanceListResource{}
+
+type instanceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type instanceListResourceModel struct {
+	framework.WithRegionModel
+	Engine types.String `tfsdk:"engine"`
+	Tags   tftags.Map   `tfsdk:"tags"`
+}
+
+func (l *instanceListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrEngine: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Database engine. Only DB instances using this engine are listed.",
+			},
+			names.AttrTags: framework.ListTagsFilterAttribute(),
+		},
+	}
+}
+
+func (l *instanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.RDSClient(ctx)
+
+	var query instanceListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input rds.DescribeDBInstancesInput
+	input.Filters = appendListFilter(input.Filters, "engine", query.Engine.ValueString())
+
+	tflog.Info(ctx, "Listing RDS DB Instances")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for instance, err := range listDBInstances(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// Aurora and Multi-AZ DB cluster members are managed via their DB cluster.
+			if instance.DBClusterIdentifier != nil || isNonRDSEngine(aws.ToString(instance.Engine)) {
+				continue
+			}
+
+			if !framework.MatchesTagsFilter(ctx, query.Tags, keyValueTags(ctx, instance.TagList)) {
+				continue
+			}
+
+			id, identifier := aws.ToString(instance.DbiResourceId), aws.ToString(instance.DBInstanceIdentifier)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+			rd.Set(names.AttrIdentifier, identifier)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading RDS DB Instance")
+				if diags := resourceInstanceRead(ctx, rd, awsClient); diags.HasError() {
+					tflog.Error(ctx, "Reading RDS DB Instance", map[string]any{
+						"diags": sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				if rd.Id() == "" {
+					continue
+				}
+			}
+
+			result.DisplayName = identifier
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listDBInstances(ctx context.Context, conn *rds.Client, input *rds.DescribeDBInstancesInput) iter.Seq2[awstypes.DBInstance, error] {
+	return func(yield func(awstypes.DBInstance, error) bool) {
+		pages := rds.NewDescribeDBInstancesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.DBInstance{}, fmt.Errorf("listing RDS DB Instances: %w", err))
+				return
+			}
+
+			for _, v := range page.DBInstances {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_db_proxy")
+func newProxyResourceAsListResource() inttypes.ListResourceForSDK {
+	l := proxyListResource{}
+	l.SetResourceSchema(resourceProxy())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &proxyListResource{}
+
+type proxyListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type proxyListResourceModel struct {
+	framework.WithRegionModel
+	EngineFamily types.String `tfsdk:"engine_family"`
+	Tags         tftags.Map   `tfsdk:"tags"`
+}
+
+func (l *proxyListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"engine_family": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Engine family. Only DB proxies in this engine family are listed.",
+			},
+			names.AttrTags: framework.ListTagsFilterAttribute(),
+		},
+	}
+}
+
+func (l *proxyListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.RDSClient(ctx)
+
+	var query proxyListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input rds.DescribeDBProxiesInput
+
+	tflog.Info(ctx, "Listing RDS DB Proxies")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for proxy, err := range listDBProxies(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if !query.EngineFamily.IsNull() && aws.ToString(proxy.EngineFamily) != query.EngineFamily.ValueString() {
+				continue
+			}
+
+			if len(query.Tags.Elements()) > 0 {
+				tags, err := listTags(ctx, conn, aws.ToString(proxy.DBProxyArn))
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("listing tags for RDS DB Proxy (%s): %w", aws.ToString(proxy.DBProxyArn), err))
+					yield(result)
+					return
+				}
+
+				if !framework.MatchesTagsFilter(ctx, query.Tags, tags) {
+					continue
+				}
+			}
+
+			id := aws.ToString(proxy.DBProxyName)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading RDS DB Proxy")
+				if diags := resourceProxyRead(ctx, rd, awsClient); diags.HasError() {
+					tflog.Error(ctx, "Reading RDS DB Proxy", map[string]any{
+						"diags": sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				if rd.Id() == "" {
+					continue
+				}
+			}
+
+			result.DisplayName = id
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listDBProxies(ctx context.Context, conn *rds.Client, input *rds.DescribeDBProxiesInput) iter.Seq2[awstypes.DBProxy, error] {
+	return func(yield func(awstypes.DBProxy, error) bool) {
+		pages := rds.NewDescribeDBProxiesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.DBProxy{}, fmt.Errorf("listing RDS DB Proxies: %w", err))
+				return
+			}
+
+			for _, v := range page.DBProxies {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
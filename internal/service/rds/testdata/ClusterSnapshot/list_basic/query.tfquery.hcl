@@ -0,0 +1,10 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_db_cluster_snapshot" "test" {
+  provider = aws
+
+  config {
+    db_cluster_identifier = aws_rds_cluster.test.id
+  }
+}
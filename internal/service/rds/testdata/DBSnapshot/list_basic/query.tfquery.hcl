@@ -0,0 +1,10 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_db_snapshot" "test" {
+  provider = aws
+
+  config {
+    db_instance_identifier = aws_db_instance.test.identifier
+  }
+}
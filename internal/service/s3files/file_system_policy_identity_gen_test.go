@@ -47,6 +47,7 @@ func TestAccS3FilesFileSystemPolicy_Identity_basic(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:   knownvalue.Null(),
 						names.AttrAccountID:    tfknownvalue.AccountID(),
 						names.AttrRegion:       knownvalue.StringExact(acctest.Region()),
 						names.AttrFileSystemID: knownvalue.NotNull(),
@@ -132,6 +133,7 @@ func TestAccS3FilesFileSystemPolicy_Identity_regionOverride(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:   knownvalue.Null(),
 						names.AttrAccountID:    tfknownvalue.AccountID(),
 						names.AttrRegion:       knownvalue.StringExact(acctest.AlternateRegion()),
 						names.AttrFileSystemID: knownvalue.NotNull(),
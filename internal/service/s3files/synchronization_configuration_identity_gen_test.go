@@ -49,6 +49,7 @@ func TestAccS3FilesSynchronizationConfiguration_Identity_basic(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:   knownvalue.Null(),
 						names.AttrAccountID:    tfknownvalue.AccountID(),
 						names.AttrRegion:       knownvalue.StringExact(acctest.Region()),
 						names.AttrFileSystemID: knownvalue.NotNull(),
@@ -134,6 +135,7 @@ func TestAccS3FilesSynchronizationConfiguration_Identity_regionOverride(t *testi
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:   knownvalue.Null(),
 						names.AttrAccountID:    tfknownvalue.AccountID(),
 						names.AttrRegion:       knownvalue.StringExact(acctest.AlternateRegion()),
 						names.AttrFileSystemID: knownvalue.NotNull(),
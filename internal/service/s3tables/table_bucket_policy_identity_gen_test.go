@@ -52,7 +52,8 @@ func TestAccS3TablesTableBucketPolicy_Identity_basic(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"table_bucket_arn": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"table_bucket_arn":   knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("table_bucket_arn")),
 				},
@@ -145,7 +146,8 @@ func TestAccS3TablesTableBucketPolicy_Identity_regionOverride(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"table_bucket_arn": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"table_bucket_arn":   knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("table_bucket_arn")),
 				},
@@ -302,7 +304,8 @@ func TestAccS3TablesTableBucketPolicy_Identity_ExistingResource_basic(t *testing
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"table_bucket_arn": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"table_bucket_arn":   knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("table_bucket_arn")),
 				},
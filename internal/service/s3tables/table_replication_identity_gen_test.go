@@ -51,7 +51,8 @@ func TestAccS3TablesTableReplication_Identity_basic(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"table_arn": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"table_arn":          knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("table_arn")),
 				},
@@ -140,7 +141,8 @@ func TestAccS3TablesTableReplication_Identity_regionOverride(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"table_arn": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"table_arn":          knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("table_arn")),
 				},
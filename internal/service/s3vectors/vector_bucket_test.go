@@ -63,7 +63,8 @@ func TestAccS3VectorsVectorBucket_basic(t *testing.T) {
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("vector_bucket_arn"), tfknownvalue.RegionalARNRegexp("s3vectors", regexache.MustCompile(`bucket/.+`))),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("vector_bucket_name"), knownvalue.StringExact(rName)),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"vector_bucket_arn": tfknownvalue.RegionalARNRegexp("s3vectors", regexache.MustCompile(`bucket/.+`)),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"vector_bucket_arn":  tfknownvalue.RegionalARNRegexp("s3vectors", regexache.MustCompile(`bucket/.+`)),
 					}),
 				},
 			},
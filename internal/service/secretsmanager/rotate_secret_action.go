@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// rotateSecretPollInterval defines polling cadence for the rotate secret action.
+	rotateSecretPollInterval = 10 * time.Second
+
+	secretVersionStageCurrent = "AWSCURRENT"
+	secretVersionStagePending = "AWSPENDING"
+
+	// secretVersionStageNone is reported while the new version has no staging labels,
+	// e.g. before it becomes visible to DescribeSecret.
+	secretVersionStageNone = "NONE"
+)
+
+// @Action(aws_secretsmanager_rotate_secret, name="Rotate Secret")
+func newRotateSecretAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &rotateSecretAction{}, nil
+}
+
+var (
+	_ action.Action = (*rotateSecretAction)(nil)
+)
+
+type rotateSecretAction struct {
+	framework.ActionWithModel[rotateSecretActionModel]
+}
+
+type rotateSecretActionModel struct {
+	framework.WithRegionModel
+	SecretID types.String `tfsdk:"secret_id"`
+	Timeout  types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *rotateSecretAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Immediately rotates a Secrets Manager secret using its configured rotation function and waits for the new version to be labelled AWSCURRENT.",
+		Attributes: map[string]schema.Attribute{
+			"secret_id": schema.StringAttribute{
+				Description: "The ARN or name of the secret to rotate",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2048),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the rotation to complete (default: 600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(3600),
+				},
+			},
+		},
+	}
+}
+
+func (a *rotateSecretAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rotateSecretActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SecretsManagerClient(ctx)
+
+	secretID := fwflex.StringValueFromFramework(ctx, config.SecretID)
+	timeout := fwactions.TimeoutOr(config.Timeout, 600*time.Second)
+
+	tflog.Info(ctx, "Starting Secrets Manager rotate secret action", map[string]any{
+		"secret_id":       secretID,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting rotation for Secrets Manager secret %s...", secretID)
+
+	secret, err := findSecretByID(ctx, conn, secretID)
+	if retry.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Secret Not Found",
+			fmt.Sprintf("Secrets Manager secret %s was not found", secretID),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe Secret",
+			fmt.Sprintf("Could not describe Secrets Manager secret %s: %s", secretID, err),
+		)
+		return
+	}
+
+	if aws.ToString(secret.RotationLambdaARN) == "" && secret.OwningService == nil {
+		resp.Diagnostics.AddError(
+			"Cannot Rotate Secret",
+			fmt.Sprintf("Secrets Manager secret %s has no rotation configured. Configure rotation, e.g. with aws_secretsmanager_secret_rotation, before invoking this action.", secretID),
+		)
+		return
+	}
+
+	input := secretsmanager.RotateSecretInput{
+		RotateImmediately: aws.Bool(true),
+		SecretId:          aws.String(secretID),
+	}
+
+	output, err := conn.RotateSecret(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Rotate Secret",
+			fmt.Sprintf("Could not rotate Secrets Manager secret %s: %s", secretID, err),
+		)
+		return
+	}
+
+	versionID := aws.ToString(output.VersionId)
+	cb(ctx, "Rotation started for Secrets Manager secret %s (version %s), waiting for version to become %s...", secretID, versionID, secretVersionStageCurrent)
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		secret, ferr := findSecretByID(ctx, conn, secretID)
+		if ferr != nil {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("describing secret: %w", ferr)
+		}
+		return actionwait.FetchResult[struct{}]{Status: actionwait.Status(secretVersionStage(secret.VersionIdsToStages[versionID]))}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(rotateSecretPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{secretVersionStageCurrent},
+		TransitionalStates: []actionwait.Status{
+			secretVersionStagePending,
+			secretVersionStageNone,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Secret version %s is currently labelled '%s', continuing to wait for '%s'...", versionID, fr.Status, secretVersionStageCurrent)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Secret Rotation",
+				fmt.Sprintf("Secrets Manager secret %s version %s was not labelled %s within %s. Check the rotation function's logs for errors: %s", secretID, versionID, secretVersionStageCurrent, timeout, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Secret Rotation",
+				fmt.Sprintf("Error while waiting for rotation of Secrets Manager secret %s: %s", secretID, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Secrets Manager secret %s has been successfully rotated to version %s", secretID, versionID)
+
+	tflog.Info(ctx, "Secrets Manager rotate secret action completed successfully", map[string]any{
+		"secret_id":  secretID,
+		"version_id": versionID,
+	})
+}
+
+// secretVersionStage summarizes a version's staging labels as a single status.
+func secretVersionStage(stages []string) string {
+	switch {
+	case slices.Contains(stages, secretVersionStageCurrent):
+		return secretVersionStageCurrent
+	case slices.Contains(stages, secretVersionStagePending):
+		return secretVersionStagePending
+	default:
+		return secretVersionStageNone
+	}
+}
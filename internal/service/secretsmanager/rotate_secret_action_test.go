@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSecretsManagerRotateSecretAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotateSecretActionConfig_managed(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretRotated(ctx, t, "aws_db_instance.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerRotateSecretAction_notConfigured(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckSecretDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRotateSecretActionConfig_notConfigured(rName),
+				ExpectError: regexache.MustCompile(`Cannot Rotate Secret`),
+			},
+		},
+	})
+}
+
+// testAccCheckSecretRotated verifies that the managed master user secret has been rotated at least once.
+func testAccCheckSecretRotated(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		secretARN := rs.Primary.Attributes["master_user_secret.0.secret_arn"]
+		conn := acctest.ProviderMeta(ctx, t).SecretsManagerClient(ctx)
+
+		output, err := tfsecretsmanager.FindSecretByID(ctx, conn, secretARN)
+		if err != nil {
+			return err
+		}
+
+		if output.LastRotatedDate == nil {
+			return fmt.Errorf("Secrets Manager Secret %s has not been rotated", secretARN)
+		}
+
+		for _, stages := range output.VersionIdsToStages {
+			if slices.Contains(stages, "AWSPENDING") {
+				return fmt.Errorf("Secrets Manager Secret %s has a pending rotation", secretARN)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccRotateSecretActionConfig_managed(rName string) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "default" {
+  engine = "mysql"
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = data.aws_rds_engine_version.default.engine
+  engine_version             = data.aws_rds_engine_version.default.version
+  license_model              = "general-public-license"
+  storage_type               = "standard"
+  preferred_instance_classes = ["db.t3.micro", "db.t2.micro", "db.t4g.micro"]
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage           = 5
+  backup_retention_period     = 0
+  engine                      = data.aws_rds_orderable_db_instance.test.engine
+  engine_version              = data.aws_rds_orderable_db_instance.test.engine_version
+  identifier                  = %[1]q
+  instance_class              = data.aws_rds_orderable_db_instance.test.instance_class
+  manage_master_user_password = true
+  skip_final_snapshot         = true
+  username                    = "tfacctest"
+}
+
+action "aws_secretsmanager_rotate_secret" "test" {
+  config {
+    secret_id = aws_db_instance.test.master_user_secret[0].secret_arn
+    timeout   = 1800
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_secretsmanager_rotate_secret.test]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccRotateSecretActionConfig_notConfigured(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "test-string"
+}
+
+action "aws_secretsmanager_rotate_secret" "test" {
+  config {
+    secret_id = aws_secretsmanager_secret_version.test.secret_id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_secretsmanager_rotate_secret.test]
+    }
+  }
+}
+`, rName)
+}
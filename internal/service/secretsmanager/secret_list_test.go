@@ -68,10 +68,12 @@ func TestAccSecretsManagerSecret_List_basic(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_secretsmanager_secret.test", map[string]knownvalue.Check{
-						names.AttrARN: arn1.ValueCheck(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrARN:        arn1.ValueCheck(),
 					}),
 					querycheck.ExpectIdentity("aws_secretsmanager_secret.test", map[string]knownvalue.Check{
-						names.AttrARN: arn2.ValueCheck(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrARN:        arn2.ValueCheck(),
 					}),
 				},
 			},
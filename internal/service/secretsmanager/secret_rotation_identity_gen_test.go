@@ -51,7 +51,8 @@ func TestAccSecretsManagerSecretRotation_Identity_basic(t *testing.T) {
 					statecheck.CompareValuePairs(resourceName, tfjsonpath.New(names.AttrID), resourceName, tfjsonpath.New("secret_id"), compare.ValuesSame()),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"secret_id": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"secret_id":          knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("secret_id")),
 				},
@@ -141,7 +142,8 @@ func TestAccSecretsManagerSecretRotation_Identity_regionOverride(t *testing.T) {
 					statecheck.CompareValuePairs(resourceName, tfjsonpath.New(names.AttrID), resourceName, tfjsonpath.New("secret_id"), compare.ValuesSame()),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"secret_id": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"secret_id":          knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("secret_id")),
 				},
@@ -294,7 +296,8 @@ func TestAccSecretsManagerSecretRotation_Identity_ExistingResource_basic(t *test
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"secret_id": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"secret_id":          knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("secret_id")),
 				},
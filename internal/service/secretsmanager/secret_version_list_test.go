@@ -60,16 +60,18 @@ func TestAccSecretsManagerSecretVersion_List_basic(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_secretsmanager_secret_version.test", map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
-						"secret_id":         secretID.ValueCheck(),
-						"version_id":        versionID1.ValueCheck(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						"secret_id":          secretID.ValueCheck(),
+						"version_id":         versionID1.ValueCheck(),
 					}),
 					querycheck.ExpectIdentity("aws_secretsmanager_secret_version.test", map[string]knownvalue.Check{
-						names.AttrAccountID: tfknownvalue.AccountID(),
-						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
-						"secret_id":         secretID.ValueCheck(),
-						"version_id":        versionID2.ValueCheck(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrAccountID:  tfknownvalue.AccountID(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						"secret_id":          secretID.ValueCheck(),
+						"version_id":         versionID2.ValueCheck(),
 					}),
 				},
 			},
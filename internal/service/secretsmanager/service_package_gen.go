@@ -22,6 +22,16 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newRotateSecretAction,
+			TypeName: "aws_secretsmanager_rotate_secret",
+			Name:     "Rotate Secret",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+	}
+}
 func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
 	return []*inttypes.ServicePackageEphemeralResource{
 		{
@@ -55,7 +55,7 @@ func resourceServiceAction() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"assume_role": { // ServiceActionDefinitionKeyAssumeRole
+						names.AttrAssumeRole: { // ServiceActionDefinitionKeyAssumeRole
 							Type:     schema.TypeString,
 							Optional: true,
 						},
@@ -269,7 +269,7 @@ func expandServiceActionDefinition(tfMap map[string]any) map[string]string {
 
 	apiObject := make(map[string]string)
 
-	if v, ok := tfMap["assume_role"].(string); ok && v != "" {
+	if v, ok := tfMap[names.AttrAssumeRole].(string); ok && v != "" {
 		apiObject[string(awstypes.ServiceActionDefinitionKeyAssumeRole)] = v
 	}
 
@@ -296,7 +296,7 @@ func flattenServiceActionDefinition(apiObject map[string]string, definitionType
 	tfMap := map[string]any{}
 
 	if v, ok := apiObject[string(awstypes.ServiceActionDefinitionKeyAssumeRole)]; ok && v != "" {
-		tfMap["assume_role"] = v
+		tfMap[names.AttrAssumeRole] = v
 	}
 
 	if v, ok := apiObject[string(awstypes.ServiceActionDefinitionKeyName)]; ok && v != "" {
@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package servicequotas
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// QuotaChecks returns the plan-time service quota checks, keyed by resource type name.
+func (p *servicePackage) QuotaChecks(context.Context) map[string]conns.QuotaCheck {
+	return map[string]conns.QuotaCheck{
+		"aws_eip": {
+			QuotaName: "EC2-VPC Elastic IPs",
+			Quota:     appliedQuotaValue("ec2", "L-0263D0A3"),
+			Usage:     eipUsage,
+		},
+		"aws_nat_gateway": {
+			QuotaName:      "NAT gateways per Availability Zone",
+			ScopeAttribute: names.AttrSubnetID,
+			Scope:          subnetAvailabilityZone,
+			Quota:          appliedQuotaValue("vpc", "L-FE5A380F"),
+			Usage:          natGatewayUsage,
+		},
+		"aws_vpc": {
+			QuotaName: "VPCs per Region",
+			Quota:     appliedQuotaValue("vpc", "L-F678F1CE"),
+			Usage:     vpcUsage,
+		},
+		"aws_vpc_security_group_egress_rule": {
+			QuotaName:      "Inbound or outbound rules per security group",
+			ScopeAttribute: "security_group_id",
+			Quota:          appliedQuotaValue("vpc", "L-0EA8095F"),
+			Usage:          securityGroupRuleUsage(true),
+		},
+		"aws_vpc_security_group_ingress_rule": {
+			QuotaName:      "Inbound or outbound rules per security group",
+			ScopeAttribute: "security_group_id",
+			Quota:          appliedQuotaValue("vpc", "L-0EA8095F"),
+			Usage:          securityGroupRuleUsage(false),
+		},
+	}
+}
+
+// appliedQuotaValue returns a function that returns the quota value applied to the account,
+// falling back to the AWS default value if none has been applied.
+func appliedQuotaValue(serviceCode, quotaCode string) func(context.Context, *conns.AWSClient) (float64, error) {
+	return func(ctx context.Context, c *conns.AWSClient) (float64, error) {
+		conn := c.ServiceQuotasClient(ctx)
+
+		serviceQuota, err := findServiceQuotaByServiceCodeAndQuotaCode(ctx, conn, serviceCode, quotaCode)
+
+		if retry.NotFound(err) {
+			serviceQuota, err = findDefaultServiceQuotaByServiceCodeAndQuotaCode(ctx, conn, serviceCode, quotaCode)
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		return aws.ToFloat64(serviceQuota.Value), nil
+	}
+}
+
+func eipUsage(ctx context.Context, c *conns.AWSClient, _ string) (int, error) {
+	input := ec2.DescribeAddressesInput{
+		Filters: []ec2types.Filter{
+			tfec2.NewFilter("domain", []string{string(ec2types.DomainTypeVpc)}),
+		},
+	}
+	output, err := tfec2.FindEIPs(ctx, c.EC2Client(ctx), &input)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return len(output), nil
+}
+
+// subnetAvailabilityZone returns the name of the specified subnet's Availability Zone.
+func subnetAvailabilityZone(ctx context.Context, c *conns.AWSClient, subnetID string) (string, error) {
+	subnet, err := tfec2.FindSubnetByID(ctx, c.EC2Client(ctx), subnetID)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(subnet.AvailabilityZone), nil
+}
+
+func natGatewayUsage(ctx context.Context, c *conns.AWSClient, availabilityZone string) (int, error) {
+	conn := c.EC2Client(ctx)
+
+	inputDS := ec2.DescribeSubnetsInput{
+		Filters: []ec2types.Filter{
+			tfec2.NewFilter("availability-zone", []string{availabilityZone}),
+		},
+	}
+	subnets, err := tfec2.FindSubnets(ctx, conn, &inputDS)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(subnets) == 0 {
+		return 0, nil
+	}
+
+	subnetIDs := make([]string, 0, len(subnets))
+	for _, v := range subnets {
+		subnetIDs = append(subnetIDs, aws.ToString(v.SubnetId))
+	}
+
+	inputDNG := ec2.DescribeNatGatewaysInput{
+		Filter: []ec2types.Filter{
+			tfec2.NewFilter("state", enum.Slice(ec2types.NatGatewayStatePending, ec2types.NatGatewayStateAvailable)),
+			tfec2.NewFilter("subnet-id", subnetIDs),
+		},
+	}
+	natGateways, err := tfec2.FindNATGateways(ctx, conn, &inputDNG)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return len(natGateways), nil
+}
+
+func vpcUsage(ctx context.Context, c *conns.AWSClient, _ string) (int, error) {
+	var input ec2.DescribeVpcsInput
+	output, err := tfec2.FindVPCs(ctx, c.EC2Client(ctx), &input)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return len(output), nil
+}
+
+// securityGroupRuleUsage returns a function that returns the number of inbound or outbound rules in a security group.
+func securityGroupRuleUsage(isEgress bool) func(context.Context, *conns.AWSClient, string) (int, error) {
+	return func(ctx context.Context, c *conns.AWSClient, securityGroupID string) (int, error) {
+		output, err := tfec2.FindSecurityGroupRulesBySecurityGroupID(ctx, c.EC2Client(ctx), securityGroupID)
+
+		if retry.NotFound(err) {
+			return 0, nil
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		var n int
+		for _, v := range output {
+			if aws.ToBool(v.IsEgress) == isEgress {
+				n++
+			}
+		}
+
+		return n, nil
+	}
+}
@@ -0,0 +1,35 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_sfn_activity" "expected" {
+  count = var.resource_count
+
+  name = "${var.rName}-expected-${count.index}"
+
+  tags = {
+    Name     = "${var.rName}-expected-${count.index}"
+    expected = var.rName
+  }
+}
+
+resource "aws_sfn_activity" "not_expected" {
+  count = var.resource_count
+
+  name = "${var.rName}-not-expected-${count.index}"
+
+  tags = {
+    Name = "${var.rName}-not-expected-${count.index}"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "resource_count" {
+  description = "Number of resources to create"
+  type        = number
+  nullable    = false
+}
@@ -51,7 +51,8 @@ func TestAccSQSQueueRedriveAllowPolicy_Identity_basic(t *testing.T) {
 					statecheck.CompareValuePairs(resourceName, tfjsonpath.New(names.AttrID), resourceName, tfjsonpath.New("queue_url"), compare.ValuesSame()),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"queue_url": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"queue_url":          knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("queue_url")),
 				},
@@ -134,7 +135,8 @@ func TestAccSQSQueueRedriveAllowPolicy_Identity_regionOverride(t *testing.T) {
 					statecheck.CompareValuePairs(resourceName, tfjsonpath.New(names.AttrID), resourceName, tfjsonpath.New("queue_url"), compare.ValuesSame()),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"queue_url": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"queue_url":          knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("queue_url")),
 				},
@@ -275,7 +277,8 @@ func TestAccSQSQueueRedriveAllowPolicy_Identity_ExistingResource_basic(t *testin
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"queue_url": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"queue_url":          knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("queue_url")),
 				},
@@ -389,7 +392,8 @@ func TestAccSQSQueueRedriveAllowPolicy_Identity_upgrade(t *testing.T) {
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"queue_url": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"queue_url":          knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("queue_url")),
 				},
@@ -450,7 +454,8 @@ func TestAccSQSQueueRedriveAllowPolicy_Identity_Upgrade_noRefresh(t *testing.T)
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						"queue_url": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						"queue_url":          knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("queue_url")),
 				},
@@ -50,6 +50,7 @@ func TestAccSSMAssociation_Identity_basic(t *testing.T) {
 					statecheck.CompareValuePairs(resourceName, tfjsonpath.New(names.AttrID), resourceName, tfjsonpath.New(names.AttrAssociationID), compare.ValuesSame()),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:    knownvalue.Null(),
 						names.AttrAccountID:     tfknownvalue.AccountID(),
 						names.AttrRegion:        knownvalue.StringExact(acctest.Region()),
 						names.AttrAssociationID: knownvalue.NotNull(),
@@ -135,6 +136,7 @@ func TestAccSSMAssociation_Identity_regionOverride(t *testing.T) {
 					statecheck.CompareValuePairs(resourceName, tfjsonpath.New(names.AttrID), resourceName, tfjsonpath.New(names.AttrAssociationID), compare.ValuesSame()),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:    knownvalue.Null(),
 						names.AttrAccountID:     tfknownvalue.AccountID(),
 						names.AttrRegion:        knownvalue.StringExact(acctest.AlternateRegion()),
 						names.AttrAssociationID: knownvalue.NotNull(),
@@ -243,6 +245,7 @@ func TestAccSSMAssociation_Identity_ExistingResource_basic(t *testing.T) {
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAssumeRole:    knownvalue.Null(),
 						names.AttrAccountID:     tfknownvalue.AccountID(),
 						names.AttrRegion:        knownvalue.StringExact(acctest.Region()),
 						names.AttrAssociationID: knownvalue.NotNull(),
@@ -52,8 +52,9 @@ func TestAccSSOAdminApplicationAssignmentConfiguration_Identity_basic(t *testing
 					statecheck.CompareValuePairs(resourceName, tfjsonpath.New(names.AttrID), resourceName, tfjsonpath.New("application_arn"), compare.ValuesSame()),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						names.AttrRegion:  knownvalue.StringExact(acctest.Region()),
-						"application_arn": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						"application_arn":    knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("application_arn")),
 				},
@@ -139,8 +140,9 @@ func TestAccSSOAdminApplicationAssignmentConfiguration_Identity_regionOverride(t
 					statecheck.CompareValuePairs(resourceName, tfjsonpath.New(names.AttrID), resourceName, tfjsonpath.New("application_arn"), compare.ValuesSame()),
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						names.AttrRegion:  knownvalue.StringExact(acctest.AlternateRegion()),
-						"application_arn": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.AlternateRegion()),
+						"application_arn":    knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("application_arn")),
 				},
@@ -285,8 +287,9 @@ func TestAccSSOAdminApplicationAssignmentConfiguration_Identity_ExistingResource
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						names.AttrRegion:  knownvalue.StringExact(acctest.Region()),
-						"application_arn": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						"application_arn":    knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("application_arn")),
 				},
@@ -350,8 +353,9 @@ func TestAccSSOAdminApplicationAssignmentConfiguration_Identity_ExistingResource
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
-						names.AttrRegion:  knownvalue.StringExact(acctest.Region()),
-						"application_arn": knownvalue.NotNull(),
+						names.AttrAssumeRole: knownvalue.Null(),
+						names.AttrRegion:     knownvalue.StringExact(acctest.Region()),
+						"application_arn":    knownvalue.NotNull(),
 					}),
 					statecheck.ExpectIdentityValueMatchesState(resourceName, tfjsonpath.New("application_arn")),
 				},
@@ -71,6 +71,7 @@ func TestAccWorkMailDomain_List_basic(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					querycheck.ExpectIdentity("aws_workmail_domain.test", map[string]knownvalue.Check{
+						names.AttrAssumeRole: knownvalue.Null(),
 						"organization_id":    knownvalue.NotNull(),
 						names.AttrDomainName: knownvalue.StringExact(domainName1),
 						names.AttrAccountID:  tfknownvalue.AccountID(),
@@ -80,6 +81,7 @@ func TestAccWorkMailDomain_List_basic(t *testing.T) {
 					tfquerycheck.ExpectNoResourceObject("aws_workmail_domain.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks())),
 
 					querycheck.ExpectIdentity("aws_workmail_domain.test", map[string]knownvalue.Check{
+						names.AttrAssumeRole: knownvalue.Null(),
 						"organization_id":    knownvalue.NotNull(),
 						names.AttrDomainName: knownvalue.StringExact(domainName2),
 						names.AttrAccountID:  tfknownvalue.AccountID(),
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"path"
+	"slices"
+)
+
+// DefaultRule contains tags to default across the resources matching its conditions.
+type DefaultRule struct {
+	Tags KeyValueTags
+
+	// ResourceTypes are the resource type names or glob patterns, e.g. "aws_rds_*", to which the rule applies.
+	// An empty value applies the rule to all resource types.
+	ResourceTypes []string
+	// ExcludeResourceTypes are the resource type names or glob patterns to which the rule does not apply.
+	ExcludeResourceTypes []string
+	// Regions are the AWS Regions in which the rule applies.
+	// An empty value applies the rule in all Regions.
+	Regions []string
+}
+
+// matches returns whether the rule applies to the specified resource type in the specified Region.
+func (r *DefaultRule) matches(typeName, region string) bool {
+	if len(r.ResourceTypes) > 0 && !matchesAny(r.ResourceTypes, typeName) {
+		return false
+	}
+
+	if matchesAny(r.ExcludeResourceTypes, typeName) {
+		return false
+	}
+
+	if len(r.Regions) > 0 && !slices.Contains(r.Regions, region) {
+		return false
+	}
+
+	return true
+}
+
+func matchesAny(patterns []string, typeName string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, typeName); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Resolve returns the DefaultConfig in effect for the specified resource type in the specified Region.
+// The tags of matching rules are merged in order over the top-level tags, with later rules taking precedence.
+func (dc *DefaultConfig) Resolve(typeName, region string) *DefaultConfig {
+	if dc == nil || len(dc.Rules) == 0 {
+		return dc
+	}
+
+	tags := dc.Tags
+	for _, rule := range dc.Rules {
+		if rule.matches(typeName, region) {
+			tags = tags.Merge(rule.Tags)
+		}
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
+}
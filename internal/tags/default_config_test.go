@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestKeyValueTagsDefaultConfigResolve(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"Owner": "platform",
+		}),
+		Rules: []DefaultRule{
+			{
+				Tags: New(ctx, map[string]string{
+					"Backup": "daily",
+				}),
+				ResourceTypes:        []string{"aws_rds_*", "aws_dynamodb_table"},
+				ExcludeResourceTypes: []string{"aws_rds_cluster_parameter_group"},
+			},
+			{
+				Tags: New(ctx, map[string]string{
+					"Backup":     "weekly",
+					"CostCenter": "eu",
+				}),
+				Regions: []string{"eu-west-1"}, //lintignore:AWSAT003
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		typeName      string
+		region        string
+		want          map[string]string
+	}{
+		{
+			name:     "nil config",
+			typeName: "aws_rds_cluster",
+			region:   "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			name:          "no rules match",
+			defaultConfig: defaultConfig,
+			typeName:      "aws_vpc",
+			region:        "us-west-2", //lintignore:AWSAT003
+			want: map[string]string{
+				"Owner": "platform",
+			},
+		},
+		{
+			name:          "resource type glob",
+			defaultConfig: defaultConfig,
+			typeName:      "aws_rds_cluster",
+			region:        "us-west-2", //lintignore:AWSAT003
+			want: map[string]string{
+				"Owner":  "platform",
+				"Backup": "daily",
+			},
+		},
+		{
+			name:          "excluded resource type",
+			defaultConfig: defaultConfig,
+			typeName:      "aws_rds_cluster_parameter_group",
+			region:        "us-west-2", //lintignore:AWSAT003
+			want: map[string]string{
+				"Owner": "platform",
+			},
+		},
+		{
+			name:          "later rule takes precedence",
+			defaultConfig: defaultConfig,
+			typeName:      "aws_dynamodb_table",
+			region:        "eu-west-1", //lintignore:AWSAT003
+			want: map[string]string{
+				"Owner":      "platform",
+				"Backup":     "weekly",
+				"CostCenter": "eu",
+			},
+		},
+		{
+			name: "rules only",
+			defaultConfig: &DefaultConfig{
+				Rules: defaultConfig.Rules,
+			},
+			typeName: "aws_vpc",
+			region:   "us-west-2", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.Resolve(testCase.typeName, testCase.region)
+
+			if testCase.want == nil {
+				if got != nil {
+					t.Fatalf("expected nil, got %v", got.Tags)
+				}
+				return
+			}
+
+			if got == nil {
+				t.Fatalf("expected %v, got nil", testCase.want)
+			}
+			if len(got.Rules) != 0 {
+				t.Errorf("expected no rules, got %d", len(got.Rules))
+			}
+			testKeyValueTagsVerifyMap(t, got.Tags.Map(), testCase.want)
+		})
+	}
+}
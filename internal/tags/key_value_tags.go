@@ -9,6 +9,7 @@ import (
 	"maps"
 	"net/url"
 	"reflect"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
@@ -58,12 +59,25 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// Rules contain tags to default across the resources matching each rule's conditions.
+	// Use Resolve to obtain the DefaultConfig in effect for a resource.
+	Rules []DefaultRule
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	// KeyRegexes are regular expressions matching the keys of tags to remove.
+	KeyRegexes []*regexp.Regexp
+	// KeyValues match tags to remove by key and value.
+	KeyValues []IgnoreKeyValue
+}
+
+// IgnoreKeyValue matches tags whose key matches a regular expression and whose value is equal to a given value.
+type IgnoreKeyValue struct {
+	KeyRegex *regexp.Regexp
+	Value    string
 }
 
 // TagPolicyConfig contains options related to organizational tagging policies.
@@ -146,6 +160,8 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnoreRegexes(config.KeyRegexes)
+	result = result.IgnoreKeyValues(config.KeyValues)
 
 	return result
 }
@@ -197,6 +213,48 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreRegexes returns non-matching tag keys.
+func (tags KeyValueTags) IgnoreRegexes(ignoreTagKeyRegexes []*regexp.Regexp) KeyValueTags {
+	if len(ignoreTagKeyRegexes) == 0 {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if slices.ContainsFunc(ignoreTagKeyRegexes, func(re *regexp.Regexp) bool {
+			return re.MatchString(k)
+		}) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreKeyValues returns tags not matching any key regular expression and value pair.
+func (tags KeyValueTags) IgnoreKeyValues(ignoreTagKeyValues []IgnoreKeyValue) KeyValueTags {
+	if len(ignoreTagKeyValues) == 0 {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if slices.ContainsFunc(ignoreTagKeyValues, func(kv IgnoreKeyValue) bool {
+			return kv.KeyRegex.MatchString(k) && v.ValueString() == kv.Value
+		}) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreServerlessApplicationRepository returns non-AWS and non-ServerlessApplicationRepository tag keys.
 func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	result := make(KeyValueTags)
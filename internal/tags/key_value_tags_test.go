@@ -5,9 +5,11 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"slices"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -565,6 +567,45 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key regexes",
+			tags: New(ctx, map[string]string{
+				"aws:cloudformation:stack-name": "stack",
+				"kubernetes.io/cluster/prod":    "owned",
+				"karpenter.sh/nodepool":         "default",
+				"Name":                          "test",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexache.MustCompile(`^aws:`),
+					regexache.MustCompile(`^karpenter\.sh/`),
+				},
+			},
+			want: map[string]string{
+				"kubernetes.io/cluster/prod": "owned",
+				"Name":                       "test",
+			},
+		},
+		{
+			name: "key values",
+			tags: New(ctx, map[string]string{
+				"kubernetes.io/cluster/prod":    "owned",
+				"kubernetes.io/cluster/staging": "shared",
+				"Name":                          "test",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyValues: []IgnoreKeyValue{
+					{
+						KeyRegex: regexache.MustCompile(`^kubernetes\.io/cluster/`),
+						Value:    "owned",
+					},
+				},
+			},
+			want: map[string]string{
+				"kubernetes.io/cluster/staging": "shared",
+				"Name":                          "test",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// reverseLookup cross references Terraform resource types to the corresponding
+// Tagris resource type name(s).
+var reverseLookup = sync.OnceValue(func() map[string][]string {
+	m := make(map[string][]string)
+	for tagrisType, tfTypes := range Lookup {
+		for _, tfType := range tfTypes {
+			m[tfType] = append(m[tfType], tagrisType)
+		}
+	}
+	for _, v := range m {
+		slices.Sort(v)
+	}
+	return m
+})
+
+// ResourceTypes returns the Tagris resource type names, e.g. "ec2:vpc",
+// which correspond to the specified Terraform resource type.
+func ResourceTypes(tfType string) []string {
+	return slices.Clone(reverseLookup()[tfType])
+}
+
+// ResourceTypeFilter returns the value to use in a Resource Groups Tagging API
+// GetResources ResourceTypeFilters list for the specified Tagris resource type.
+// Child resource types, e.g. "appmesh:mesh/virtualNode", are filtered by their
+// top-level resource type and must be matched using MatchesResourceType.
+func ResourceTypeFilter(tagrisType string) string {
+	service, resourceType, _ := strings.Cut(tagrisType, ":")
+	first, _, _ := strings.Cut(resourceType, "/")
+
+	return service + ":" + first
+}
+
+// MatchesResourceType returns whether the specified ARN identifies a resource
+// of the specified Tagris resource type.
+func MatchesResourceType(s, tagrisType string) bool {
+	service, resourceType, ok := strings.Cut(tagrisType, ":")
+	if !ok {
+		return false
+	}
+
+	if !matchesResourceType(s, service, resourceType) {
+		return false
+	}
+
+	// Don't match parent resource types if the ARN is for a more specific child resource type.
+	prefix := tagrisType + "/"
+	for k := range Lookup {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+
+		if _, v, _ := strings.Cut(k, ":"); matchesResourceType(s, service, v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchesResourceType(s, service, resourceType string) bool {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return false
+	}
+
+	if v.Service != service {
+		return false
+	}
+
+	// Some ARNs, e.g. SQS queues and SNS topics, don't include a resource type.
+	i := strings.IndexAny(v.Resource, ":/")
+	if i == -1 {
+		return !strings.Contains(resourceType, "/")
+	}
+
+	// Resource types and identifiers alternate, e.g. "mesh/my-mesh/virtualNode/my-node".
+	segments := strings.Split(v.Resource[:i]+"/"+v.Resource[i+1:], "/")
+	components := strings.Split(resourceType, "/")
+
+	if len(components) == 1 {
+		return segments[0] == components[0]
+	}
+
+	if len(segments) < 2*len(components) {
+		return false
+	}
+
+	for i, component := range components {
+		if segments[2*i] != component {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfType   string
+		expected []string
+	}{
+		"ec2 instance": {
+			tfType:   "aws_instance",
+			expected: []string{"ec2:instance"},
+		},
+		"child resource type": {
+			tfType:   "aws_appmesh_virtual_node",
+			expected: []string{"appmesh:mesh/virtualNode"},
+		},
+		"unknown": {
+			tfType: "aws_unknown",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ResourceTypes(testCase.tfType), testCase.expected; !slices.Equal(got, want) {
+				t.Errorf("ResourceTypes(%q) = %v, want %v", testCase.tfType, got, want)
+			}
+		})
+	}
+}
+
+func TestResourceTypeFilter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tagrisType string
+		expected   string
+	}{
+		"top-level": {
+			tagrisType: "ec2:vpc",
+			expected:   "ec2:vpc",
+		},
+		"child": {
+			tagrisType: "appmesh:mesh/virtualRouter/route",
+			expected:   "appmesh:mesh",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ResourceTypeFilter(testCase.tagrisType), testCase.expected; got != want {
+				t.Errorf("ResourceTypeFilter(%q) = %q, want %q", testCase.tagrisType, got, want)
+			}
+		})
+	}
+}
+
+func TestMatchesResourceType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn        string
+		tagrisType string
+		expected   bool
+	}{
+		"slash delimiter": {
+			arn:        "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678", //lintignore:AWSAT003,AWSAT005
+			tagrisType: "ec2:vpc",
+			expected:   true,
+		},
+		"colon delimiter": {
+			arn:        "arn:aws:lambda:us-west-2:123456789012:function:example", //lintignore:AWSAT003,AWSAT005
+			tagrisType: "lambda:function",
+			expected:   true,
+		},
+		"no resource type": {
+			arn:        "arn:aws:sqs:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			tagrisType: "sqs:queue",
+			expected:   true,
+		},
+		"path": {
+			arn:        "arn:aws:iam::123456789012:role/path/example", //lintignore:AWSAT005
+			tagrisType: "iam:role",
+			expected:   true,
+		},
+		"different resource type": {
+			arn:        "arn:aws:ec2:us-west-2:123456789012:subnet/subnet-12345678", //lintignore:AWSAT003,AWSAT005
+			tagrisType: "ec2:vpc",
+		},
+		"different service": {
+			arn:        "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678", //lintignore:AWSAT003,AWSAT005
+			tagrisType: "vpc-lattice:service",
+		},
+		"parent": {
+			arn:        "arn:aws:appmesh:us-west-2:123456789012:mesh/example", //lintignore:AWSAT003,AWSAT005
+			tagrisType: "appmesh:mesh",
+			expected:   true,
+		},
+		"parent of child": {
+			arn:        "arn:aws:appmesh:us-west-2:123456789012:mesh/example/virtualNode/node", //lintignore:AWSAT003,AWSAT005
+			tagrisType: "appmesh:mesh",
+		},
+		"child": {
+			arn:        "arn:aws:appmesh:us-west-2:123456789012:mesh/example/virtualNode/node", //lintignore:AWSAT003,AWSAT005
+			tagrisType: "appmesh:mesh/virtualNode",
+			expected:   true,
+		},
+		"child of child": {
+			arn:        "arn:aws:appmesh:us-west-2:123456789012:mesh/example/virtualRouter/router/route/route", //lintignore:AWSAT003,AWSAT005
+			tagrisType: "appmesh:mesh/virtualRouter",
+		},
+		"invalid ARN": {
+			arn:        "vpc-12345678",
+			tagrisType: "ec2:vpc",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := MatchesResourceType(testCase.arn, testCase.tagrisType), testCase.expected; got != want {
+				t.Errorf("MatchesResourceType(%q, %q) = %t, want %t", testCase.arn, testCase.tagrisType, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+// IAMRoleARNPatternNoAnchors is the regex pattern for matching IAM role ARNs,
+// without anchors for use within larger regex patterns.
+const IAMRoleARNPatternNoAnchors = `arn:[a-z-]+:iam::\d{12}:role/\S+`
@@ -10,6 +10,7 @@ import (
 	"runtime/debug"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -44,6 +45,10 @@ func main() {
 		serveOpts...,
 	)
 
+	if err := conns.ShutdownTracing(context.Background()); err != nil {
+		log.Printf("[WARN] shutting down tracing: %s", err)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
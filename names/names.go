@@ -303,4 +303,10 @@ const (
 	ActionTopLevelRegionAttributeDescription       = `Region where this action will be [executed](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints). ` + topLevelRegionDefaultDescription
 
 	topLevelRegionDefaultDescription = `Defaults to the Region set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference).`
+
+	ResourceTopLevelAssumeRoleAttributeDescription     = `ARN of an IAM role to [assume](https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_use.html) when this resource is managed. ` + topLevelAssumeRoleDefaultDescription
+	ListResourceTopLevelAssumeRoleAttributeDescription = `ARN of an IAM role to [assume](https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_use.html) when querying for resources of this type. ` + topLevelAssumeRoleDefaultDescription
+	ActionTopLevelAssumeRoleAttributeDescription       = `ARN of an IAM role to [assume](https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_use.html) when this action is executed. ` + topLevelAssumeRoleDefaultDescription
+
+	topLevelAssumeRoleDefaultDescription = `Defaults to the credentials set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference).`
 )
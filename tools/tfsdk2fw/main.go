@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 )
 
@@ -86,22 +87,35 @@ func main() {
 		migrator.TFTypeName = v
 	}
 
+	if !migrator.IsDataSource {
+		pkg, err := scanServicePackage(path.Dir(outputFilename))
+
+		if err != nil {
+			g.Fatalf("scanning service package: %s", err)
+		}
+
+		migrator.ServicePackage = pkg
+	}
+
 	if err := migrator.migrate(outputFilename); err != nil {
 		g.Fatalf("error migrating Terraform %s schema: %s", *resourceType, err)
 	}
 }
 
 type migrator struct {
-	Generator    *common.Generator
-	IsDataSource bool
-	Name         string
-	PackageName  string
-	Resource     *schema.Resource
-	Template     string
-	TFTypeName   string
+	Generator      *common.Generator
+	IsDataSource   bool
+	Name           string
+	PackageName    string
+	Resource       *schema.Resource
+	ServicePackage *servicePackage
+	Template       string
+	TFTypeName     string
 }
 
 // migrate generates an identical schema into the specified output file.
+// For resources, a state upgrader is generated into <output-file>_migrate.go and
+// a test comparing the Plugin SDK v2 and Plugin Framework schemas into <output-file>_migrate_test.go.
 func (m *migrator) migrate(outputFilename string) error {
 	m.infof("generating into %[1]q", outputFilename)
 
@@ -125,7 +139,36 @@ func (m *migrator) migrate(outputFilename string) error {
 		return err
 	}
 
-	return d.Write()
+	if err := d.Write(); err != nil {
+		return err
+	}
+
+	if m.IsDataSource {
+		return nil
+	}
+
+	basename := strings.TrimSuffix(outputFilename, ".go")
+
+	for _, v := range []struct {
+		filename, template string
+	}{
+		{basename + "_migrate.go", migrateImpl},
+		{basename + "_migrate_test.go", migrateTestImpl},
+	} {
+		m.infof("generating into %[1]q", v.filename)
+
+		d := m.Generator.NewGoFileDestination(v.filename)
+
+		if err := d.BufferTemplate("migrate", v.template, templateData); err != nil {
+			return err
+		}
+
+		if err := d.Write(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (m *migrator) generateTemplateData() (*templateData, error) {
@@ -134,6 +177,7 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	emitter := &emitter{
 		Generator:    m.Generator,
 		IsDataSource: m.IsDataSource,
+		ModelNames:   make(map[string]struct{}),
 		SchemaWriter: &sbSchema,
 		StructWriter: &sbStruct,
 	}
@@ -156,10 +200,30 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
 		Name:                         m.Name,
+		NestedModels:                 strings.Join(emitter.NestedModels, "\n"),
 		PackageName:                  m.PackageName,
-		Schema:                       sbSchema.String(),
 		Struct:                       sbStruct.String(),
 		TFTypeName:                   m.TFTypeName,
+		TopLevelFields:               emitter.TopLevelFields,
+	}
+
+	if m.IsDataSource {
+		templateData.Schema = fmt.Sprintf("schema.Schema{\n%s}", sbSchema.String())
+	} else {
+		// The Plugin Framework resource's schema version is one greater than the Plugin SDK v2 resource's
+		// so that existing state is passed through a state upgrader.
+		templateData.PriorSchemaVersion = int64(m.Resource.SchemaVersion)
+		templateData.SchemaVersion = templateData.PriorSchemaVersion + 1
+		templateData.PriorSchema = fmt.Sprintf("schema.Schema{\nVersion:%d,\n%s}", templateData.PriorSchemaVersion, sbSchema.String())
+		templateData.Schema = fmt.Sprintf("schema.Schema{\nVersion:%d,\n%s}", templateData.SchemaVersion, sbSchema.String())
+
+		if err := m.addCRUDTemplateData(templateData); err != nil {
+			return nil, err
+		}
+
+		for version := range m.Resource.StateUpgraders {
+			m.Generator.Warnf("Plugin SDK v2 state upgrader for schema version %d must be migrated manually", version)
+		}
 	}
 
 	for _, v := range emitter.FrameworkPlanModifierPackages {
@@ -181,6 +245,72 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	return templateData, nil
 }
 
+// addCRUDTemplateData adds the names of the service package's existing functions called from the resource's CRUD handlers.
+func (m *migrator) addCRUDTemplateData(templateData *templateData) error {
+	templateData.HumanName = m.TFTypeName
+	templateData.ServiceClient = "TODO"
+
+	if service, err := data.LookupService(m.PackageName); err != nil {
+		m.Generator.Warnf("looking up service package data for %q: %s", m.PackageName, err)
+	} else {
+		templateData.HumanName = service.HumanFriendly()
+		templateData.ServiceClient = service.ProviderNameUpper()
+	}
+
+	pkg := m.ServicePackage
+	sdkResourceFunc, humanName := pkg.annotatedFunc(fmt.Sprintf(`@SDKResource(%q`, m.TFTypeName))
+	if sdkResourceFunc == "" {
+		return fmt.Errorf("Plugin SDK v2 resource factory function for %s not found in service package", m.TFTypeName)
+	}
+
+	if humanName != "" {
+		templateData.HumanName += " " + humanName
+	}
+	if sdkResourceFunc == "resource"+m.Name {
+		m.Generator.Warnf("Plugin SDK v2 resource factory function %s conflicts with the generated resource type; rename it until the migration is complete", sdkResourceFunc)
+	}
+	templateData.SDKResourceFunc = sdkResourceFunc
+	templateData.SDKCRUDFuncs = pkg.resourceCRUDFuncs(sdkResourceFunc)
+
+	var createTimeout, readTimeout, updateTimeout, deleteTimeout string
+	if templateData.DefaultCreateTimeout > 0 {
+		createTimeout = "createTimeout"
+	}
+	if templateData.DefaultReadTimeout > 0 {
+		readTimeout = "readTimeout"
+	}
+	if templateData.DefaultUpdateTimeout > 0 {
+		updateTimeout = "updateTimeout"
+	}
+	if templateData.DefaultDeleteTimeout > 0 {
+		deleteTimeout = "deleteTimeout"
+	}
+
+	name := m.Name
+	templateData.Finder = newFuncCall(pkg.findFunc("find"+name+"ByID", "find"+name+"By*", "find"+name), "data.ID.ValueString()", readTimeout)
+	templateData.WaitCreated = newFuncCall(pkg.findFunc("wait"+name+"Created", "wait"+name+"Available"), "data.ID.ValueString()", createTimeout)
+	templateData.WaitUpdated = newFuncCall(pkg.findFunc("wait"+name+"Updated"), "new.ID.ValueString()", updateTimeout)
+	templateData.WaitDeleted = newFuncCall(pkg.findFunc("wait"+name+"Deleted"), "data.ID.ValueString()", deleteTimeout)
+
+	for _, v := range []struct {
+		call *funcCall
+		what string
+	}{
+		{templateData.Finder, "finder"},
+		{templateData.WaitCreated, "create waiter"},
+		{templateData.WaitUpdated, "update waiter"},
+		{templateData.WaitDeleted, "delete waiter"},
+	} {
+		if v.call == nil {
+			m.Generator.Warnf("no %s found for %s", v.what, name)
+		} else {
+			m.infof("using %s %s", v.what, v.call.Name)
+		}
+	}
+
+	return nil
+}
+
 func (m *migrator) infof(format string, a ...any) {
 	m.Generator.Infof(format, a...)
 }
@@ -200,11 +330,15 @@ type emitter struct {
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
+	ModelNames                    map[string]struct{} // Names of nested block models.
+	NestedModels                  []string            // Type declarations of nested block models.
 	SchemaWriter                  io.Writer
-	StructWriter                  io.Writer
+	StructWriter                  io.Writer // Writer for the fields of the current model.
+	TopLevelFields                []string  // Names of the fields of the top-level model.
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
+// The fields of the schema.Schema composite literal are emitted, without the enclosing braces.
 func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 	if _, ok := resource.Schema["id"]; ok {
 		e.warnf("Explicit `id` attribute defined")
@@ -233,7 +367,11 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 		}
 	}
 
-	fprintf(e.SchemaWriter, "schema.Schema{\n")
+	for name, property := range resource.Schema {
+		if !isAttribute(property) {
+			e.ModelNames[naming.ToCamelCase(name)] = struct{}{}
+		}
+	}
 
 	err := e.emitAttributesAndBlocks(nil, resource.Schema)
 
@@ -241,10 +379,6 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 		return err
 	}
 
-	if version := resource.SchemaVersion; version > 0 {
-		fprintf(e.SchemaWriter, "Version:%d,\n", version)
-	}
-
 	if description := resource.Description; description != "" {
 		fprintf(e.SchemaWriter, "Description:%q,\n", description)
 	}
@@ -253,8 +387,6 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 		fprintf(e.SchemaWriter, "DeprecationMessage:%q,\n", deprecationMessage)
 	}
 
-	fprintf(e.SchemaWriter, "}")
-
 	return nil
 }
 
@@ -295,21 +427,23 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 		fprintf(e.SchemaWriter, "%q:", name)
 
+		fieldName := naming.ToCamelCase(name)
+		fprintf(e.StructWriter, "%s ", fieldName)
+
 		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
+			e.TopLevelFields = append(e.TopLevelFields, fieldName)
 		}
 
 		if name == "id" && isTopLevelAttribute {
 			fprintf(e.SchemaWriter, "framework.IDAttribute()")
+			fprintf(e.StructWriter, "types.String")
 		} else {
 			if err := e.emitAttributeProperty(append(path, name), property); err != nil {
 				return err
 			}
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 
 		fprintf(e.SchemaWriter, ",\n")
 	}
@@ -332,12 +466,21 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 		fprintf(e.SchemaWriter, "%q:", name)
 
+		fieldName := naming.ToCamelCase(name)
+		fprintf(e.StructWriter, "%s ", fieldName)
+
+		if isTopLevelAttribute {
+			e.TopLevelFields = append(e.TopLevelFields, fieldName)
+		}
+
 		err := e.emitBlockProperty(append(path, name), property)
 
 		if err != nil {
 			return err
 		}
 
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
+
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -365,9 +508,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(e.StructWriter, "types.Bool")
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
@@ -375,9 +516,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(e.StructWriter, "types.Float64")
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
@@ -385,9 +524,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(e.StructWriter, "types.Int64")
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
@@ -400,15 +537,11 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(e.StructWriter, "fwtypes.ARN")
 		} else {
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(e.StructWriter, "types.String")
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -425,9 +558,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
+			fprintf(e.StructWriter, "types.List")
 
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
@@ -438,9 +569,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
+			fprintf(e.StructWriter, "types.Map")
 
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
@@ -451,9 +580,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
+			fprintf(e.StructWriter, "types.Set")
 
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
@@ -633,10 +760,15 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
+			modelName := e.nestedModelName(path)
+			e.ImportProviderFrameworkTypes = true
+
+			fprintf(e.StructWriter, "fwtypes.ListNestedObjectValueOf[%s]", modelName)
 			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitNestedModel(modelName, path, v.Schema)
 
 			if err != nil {
 				return err
@@ -656,10 +788,15 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 
+			modelName := e.nestedModelName(path)
+			e.ImportProviderFrameworkTypes = true
+
+			fprintf(e.StructWriter, "fwtypes.SetNestedObjectValueOf[%s]", modelName)
 			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewSetNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitNestedModel(modelName, path, v.Schema)
 
 			if err != nil {
 				return err
@@ -731,6 +868,41 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	return nil
 }
 
+// nestedModelName returns a unique name for the model of the nested block at the specified path.
+func (e *emitter) nestedModelName(path []string) string {
+	name := naming.ToCamelCase(path[len(path)-1])
+
+	// Top-level block names are reserved up front; disambiguate identically named nested blocks.
+	if len(path) > 1 {
+		if _, ok := e.ModelNames[name]; ok {
+			name = naming.ToCamelCase(strings.Join(path, "_"))
+		}
+		e.ModelNames[name] = struct{}{}
+	}
+
+	return strings.ToLower(name[:1]) + name[1:] + "Model"
+}
+
+// emitNestedModel generates the Plugin Framework code for a Plugin SDK nested block's Attributes and Blocks
+// and emits the generated code to the emitter's Writer.
+// The nested block's model type declaration is added to the emitter's NestedModels.
+func (e *emitter) emitNestedModel(modelName string, path []string, schema map[string]*schema.Schema) error {
+	sbStruct := strings.Builder{}
+	structWriter := e.StructWriter
+	e.StructWriter = &sbStruct
+	defer func() {
+		e.StructWriter = structWriter
+	}()
+
+	if err := e.emitAttributesAndBlocks(path, schema); err != nil {
+		return err
+	}
+
+	e.NestedModels = append(e.NestedModels, fmt.Sprintf("type %s struct {\n%s}\n", modelName, sbStruct.String()))
+
+	return nil
+}
+
 // emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
 // and emits the generated code to the emitter's Writer.
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
@@ -915,11 +1087,24 @@ type templateData struct {
 	HasTimeouts                   bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	Finder                        *funcCall
+	HumanName                     string // e.g. EC2 Instance
 	Name                          string // e.g. Instance
+	NestedModels                  string
 	PackageName                   string // e.g. ec2
+	PriorSchema                   string
+	PriorSchemaVersion            int64
 	Schema                        string
+	SchemaVersion                 int64
+	SDKCRUDFuncs                  map[string]string // Plugin SDK v2 CRUD handlers, keyed by operation.
+	SDKResourceFunc               string            // e.g. resourceInstance
+	ServiceClient                 string            // e.g. EC2
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
+	TopLevelFields                []string
+	WaitCreated                   *funcCall
+	WaitDeleted                   *funcCall
+	WaitUpdated                   *funcCall
 }
 
 //go:embed datasource.gtpl
@@ -928,6 +1113,12 @@ var datasourceImpl string
 //go:embed resource.gtpl
 var resourceImpl string
 
+//go:embed migrate.gtpl
+var migrateImpl string
+
+//go:embed migratetest.gtpl
+var migrateTestImpl string
+
 type goImport struct {
 	Path  string
 	Alias string
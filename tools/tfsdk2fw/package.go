@@ -0,0 +1,209 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// servicePackage is the result of scanning the Go source files of the service package that the migrated resource belongs to.
+type servicePackage struct {
+	funcs map[string]*ast.FuncDecl
+}
+
+// scanServicePackage parses the non-test Go source files in the specified directory.
+// A missing directory is not an error.
+func scanServicePackage(dirname string) (*servicePackage, error) {
+	pkg := &servicePackage{
+		funcs: make(map[string]*ast.FuncDecl),
+	}
+
+	entries, err := os.ReadDir(dirname)
+
+	if os.IsNotExist(err) {
+		return pkg, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dirname, name), nil, parser.ParseComments)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", name, err)
+		}
+
+		for _, decl := range file.Decls {
+			if decl, ok := decl.(*ast.FuncDecl); ok && decl.Recv == nil {
+				pkg.funcs[decl.Name.Name] = decl
+			}
+		}
+	}
+
+	return pkg, nil
+}
+
+// annotatedFunc returns the name of the function with the specified annotation (e.g. `@SDKResource("aws_instance"`)
+// and the value of the annotation's `name` argument, if any.
+func (p *servicePackage) annotatedFunc(annotation string) (string, string) {
+	for _, name := range p.sortedFuncNames() {
+		decl := p.funcs[name]
+		if decl.Doc == nil {
+			continue
+		}
+
+		for _, line := range decl.Doc.List {
+			if _, args, ok := strings.Cut(line.Text, annotation); ok {
+				var humanName string
+				if _, v, ok := strings.Cut(args, `name="`); ok {
+					humanName, _, _ = strings.Cut(v, `"`)
+				}
+
+				return name, humanName
+			}
+		}
+	}
+
+	return "", ""
+}
+
+// findFunc returns the first function whose name matches one of the specified names (case-insensitively), in order of precedence.
+// A name ending in "*" matches any function with that prefix.
+func (p *servicePackage) findFunc(names ...string) *ast.FuncDecl {
+	funcNames := p.sortedFuncNames()
+
+	for _, name := range names {
+		name = strings.ToLower(name)
+		prefix, wildcard := strings.CutSuffix(name, "*")
+
+		for _, funcName := range funcNames {
+			if v := strings.ToLower(funcName); v == name || (wildcard && strings.HasPrefix(v, prefix)) {
+				return p.funcs[funcName]
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *servicePackage) sortedFuncNames() []string {
+	names := make([]string, 0, len(p.funcs))
+	for name := range p.funcs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// funcCall is a call to an existing function in the service package.
+type funcCall struct {
+	Args    string // Comma-separated arguments.
+	Name    string
+	Results int // Number of results returned.
+}
+
+// newFuncCall returns a call to the specified function.
+// Arguments are guessed from each parameter's type; the first string parameter is the resource's ID.
+// Arguments that cannot be guessed are emitted as TODO.
+func newFuncCall(decl *ast.FuncDecl, id, timeout string) *funcCall {
+	if decl == nil {
+		return nil
+	}
+
+	var args []string
+	idUsed := false
+	for _, field := range decl.Type.Params.List {
+		typ := types.ExprString(field.Type)
+
+		// Parameters may share a type, e.g. (ctx context.Context, conn *ec2.Client, id, name string).
+		for range max(len(field.Names), 1) {
+			var arg string
+			switch {
+			case typ == "context.Context":
+				arg = "ctx"
+			case strings.HasSuffix(typ, ".Client"):
+				arg = "conn"
+			case typ == "string" && !idUsed:
+				arg = id
+				idUsed = true
+			case typ == "time.Duration" && timeout != "":
+				arg = timeout
+			default:
+				arg = "TODO"
+			}
+			args = append(args, arg)
+		}
+	}
+
+	var results int
+	if v := decl.Type.Results; v != nil {
+		results = v.NumFields()
+	}
+
+	return &funcCall{
+		Args:    strings.Join(args, ", "),
+		Name:    decl.Name.Name,
+		Results: results,
+	}
+}
+
+// resourceCRUDFuncs returns the names of the CRUD handlers (keyed by "Create", "Read", "Update" and "Delete")
+// set in the schema.Resource returned by the specified Plugin SDK v2 resource factory function.
+func (p *servicePackage) resourceCRUDFuncs(factory string) map[string]string {
+	crudFuncs := make(map[string]string)
+
+	decl, ok := p.funcs[factory]
+	if !ok || decl.Body == nil {
+		return crudFuncs
+	}
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+		if !ok {
+			return true
+		}
+
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		value, ok := kv.Value.(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		for _, op := range []string{"Create", "Read", "Update", "Delete"} {
+			if _, exists := crudFuncs[op]; exists {
+				continue
+			}
+
+			switch key.Name {
+			case op, op + "Context", op + "WithoutTimeout":
+				crudFuncs[op] = value.Name
+			}
+		}
+
+		return true
+	})
+
+	return crudFuncs
+}